@@ -115,17 +115,11 @@ func cmdGetAgencyState(cmd *cobra.Command, _ []string) {
 			d.GetName())
 	}
 
-	dnsName := k8sutil.CreatePodDNSName(d.GetObjectMeta(), api.ServerGroupAgents.AsRole(), d.Status.Members.Agents[0].ID)
-	endpoint := getArangoEndpoint(d.GetAcceptedSpec().IsSecure(), dnsName)
-	conn := createClient([]string{endpoint}, certCA, auth, connection.ApplicationJSON)
-	leaderID, err := getAgencyLeader(ctx, conn)
+	conn, err := getAgencyLeaderConnection(ctx, d, certCA, auth)
 	if err != nil {
 		logger.Err(err).Fatal("failed to get leader ID")
 	}
 
-	dnsLeaderName := k8sutil.CreatePodDNSName(d.GetObjectMeta(), api.ServerGroupAgents.AsRole(), leaderID)
-	leaderEndpoint := getArangoEndpoint(d.GetAcceptedSpec().IsSecure(), dnsLeaderName)
-	conn = createClient([]string{leaderEndpoint}, certCA, auth, connection.PlainText)
 	body, err := getAgencyState(ctx, conn)
 	if body != nil {
 		defer body.Close()
@@ -165,6 +159,27 @@ func cmdGetAgencyDump(cmd *cobra.Command, _ []string) {
 	io.Copy(os.Stdout, body)
 }
 
+// getAgencyLeaderConnection returns connection to the leader of the agency.
+func getAgencyLeaderConnection(ctx context.Context, d api.ArangoDeployment, certCA *x509.CertPool,
+	auth connection.Authentication) (connection.Connection, error) {
+	if len(d.Status.Members.Agents) == 0 {
+		return nil, errors.New("there are no agents in the deployment")
+	}
+
+	dnsName := k8sutil.CreatePodDNSName(d.GetObjectMeta(), api.ServerGroupAgents.AsRole(), d.Status.Members.Agents[0].ID)
+	endpoint := getArangoEndpoint(d.GetAcceptedSpec().IsSecure(), dnsName)
+	conn := createClient([]string{endpoint}, certCA, auth, connection.ApplicationJSON)
+	leaderID, err := getAgencyLeader(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsLeaderName := k8sutil.CreatePodDNSName(d.GetObjectMeta(), api.ServerGroupAgents.AsRole(), leaderID)
+	leaderEndpoint := getArangoEndpoint(d.GetAcceptedSpec().IsSecure(), dnsLeaderName)
+
+	return createClient([]string{leaderEndpoint}, certCA, auth, connection.PlainText), nil
+}
+
 // getAgencyState returns the current state in the agency.
 func getAgencyState(ctx context.Context, conn connection.Connection) (io.ReadCloser, error) {
 	url := connection.NewUrl("_api", "agency", "read")
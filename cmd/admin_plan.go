@@ -0,0 +1,266 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+)
+
+const (
+	ArgPlanFile            = "file"
+	ArgOperatorEndpoint    = "operator-endpoint"
+	ArgOperatorAdminSecret = "operator-admin-secret-name"
+	ArgPlanMember          = "member"
+)
+
+func init() {
+	cmdAdmin.AddCommand(cmdPlan)
+
+	cmdPlan.AddCommand(cmdPlanPreview)
+	cmdPlanPreview.Flags().StringP(ArgPlanFile, "f", "", "ArangoDeployment file with the proposed spec")
+	cmdPlanPreview.Flags().StringP(ArgDeploymentName, "d", "",
+		"name of the deployment - by default name from the file is used")
+	cmdPlanPreview.Flags().String(ArgOperatorEndpoint, fmt.Sprintf("https://127.0.0.1:%d", defaultServerPort),
+		"endpoint of the operator HTTP server")
+	cmdPlanPreview.Flags().String(ArgOperatorAdminSecret, defaultAdminSecretName,
+		"name of secret containing username + password for login to the operator HTTP server")
+
+	cmdPlan.AddCommand(cmdPlanHistory)
+	cmdPlanHistory.Flags().StringP(ArgDeploymentName, "d", "",
+		"necessary when more than one deployment exist within on namespace")
+	cmdPlanHistory.Flags().String(ArgPlanMember, "", "show only actions of the member with given ID")
+}
+
+var cmdPlan = &cobra.Command{
+	Use:   "plan",
+	Short: "Plan operations",
+	Run:   executeUsage,
+}
+
+var cmdPlanPreview = &cobra.Command{
+	Use:   "preview",
+	Short: "Preview plan for the proposed spec",
+	Long:  "It prints the plan which operator would create for the proposed spec, together with rotation mode of each member, without applying it",
+	Run:   cmdPlanPreviewRun,
+}
+
+var cmdPlanHistory = &cobra.Command{
+	Use:   "history",
+	Short: "Get plan execution history",
+	Long:  "It prints the latest actions executed by the operator on the stdout",
+	Run:   cmdPlanHistoryRun,
+}
+
+func cmdPlanHistoryRun(cmd *cobra.Command, _ []string) {
+	deploymentName, _ := cmd.Flags().GetString(ArgDeploymentName)
+	memberID, _ := cmd.Flags().GetString(ArgPlanMember)
+
+	namespace := os.Getenv(constants.EnvOperatorPodNamespace)
+	if len(namespace) == 0 {
+		logger.Fatal("\"%s\" environment variable missing", constants.EnvOperatorPodNamespace)
+	}
+
+	d, err := getDeployment(getInterruptionContext(), namespace, deploymentName)
+	if err != nil {
+		logger.Err(err).Fatal("failed to get deployment")
+	}
+
+	history := d.Status.PlanHistory
+	if memberID != "" {
+		history = history.FilterByMember(memberID)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "END\tPRIORITY\tACTION\tGROUP\tMEMBER\tDURATION\tRESULT\tREASON\tERROR")
+	for _, e := range history {
+		var duration time.Duration
+		if e.StartTime != nil {
+			duration = e.EndTime.Sub(e.StartTime.Time).Round(time.Second)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", e.EndTime.UTC().Format(time.RFC3339), e.Priority, e.Type,
+			e.Group.AsRole(), e.MemberID, duration, e.Result, e.Reason, e.Error)
+	}
+	w.Flush()
+}
+
+func cmdPlanPreviewRun(cmd *cobra.Command, _ []string) {
+	file, _ := cmd.Flags().GetString(ArgPlanFile)
+	deploymentName, _ := cmd.Flags().GetString(ArgDeploymentName)
+	endpoint, _ := cmd.Flags().GetString(ArgOperatorEndpoint)
+	adminSecretName, _ := cmd.Flags().GetString(ArgOperatorAdminSecret)
+
+	if file == "" {
+		logger.Fatal("file with the proposed spec is required")
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		logger.Err(err).Fatal("failed to read file %s", file)
+	}
+
+	var depl api.ArangoDeployment
+	if err := yaml.Unmarshal(data, &depl); err != nil {
+		logger.Err(err).Fatal("failed to parse file %s", file)
+	}
+
+	if deploymentName == "" {
+		deploymentName = depl.GetName()
+	}
+
+	ctx := getInterruptionContext()
+
+	if deploymentName == "" {
+		namespace := os.Getenv(constants.EnvOperatorPodNamespace)
+		d, err := getDeployment(ctx, namespace, "")
+		if err != nil {
+			logger.Err(err).Fatal("failed to get deployment")
+		}
+		deploymentName = d.GetName()
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			// Operator uses self-signed certificate by default
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // nolint:gosec
+		},
+	}
+
+	token, err := getOperatorToken(ctx, client, endpoint, adminSecretName)
+	if err != nil {
+		logger.Err(err).Fatal("failed to login to the operator")
+	}
+
+	body, err := json.Marshal(depl)
+	if err != nil {
+		logger.Err(err).Fatal("failed to encode deployment")
+	}
+
+	resp, err := callOperator(ctx, client, endpoint+"/api/deployment/"+deploymentName+"/plan/preview", token, body)
+	if err != nil {
+		logger.Err(err).Fatal("failed to preview plan")
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, resp, "", "  "); err != nil {
+		logger.Err(err).Fatal("failed to format response")
+	}
+
+	out.WriteString("\n")
+	out.WriteTo(os.Stdout)
+}
+
+// getOperatorToken returns token for the operator HTTP server.
+// Empty token is returned when admin secret does not exist, what is valid when anonymous access is allowed.
+func getOperatorToken(ctx context.Context, client *http.Client, endpoint, adminSecretName string) (string, error) {
+	namespace := os.Getenv(constants.EnvOperatorPodNamespace)
+	if len(namespace) == 0 {
+		return "", errors.Newf("\"%s\" environment variable missing", constants.EnvOperatorPodNamespace)
+	}
+
+	kubeClient, ok := kclient.GetDefaultFactory().Client()
+	if !ok {
+		return "", errors.Newf("Client not initialised")
+	}
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+
+	secret, err := kubeClient.Kubernetes().CoreV1().Secrets(namespace).Get(ctxChild, adminSecretName, meta.GetOptions{})
+	if err != nil {
+		if api.IsNotFound(err) {
+			return "", nil
+		}
+		return "", errors.WithMessage(err, fmt.Sprintf("failed to get secret \"%s\"", adminSecretName))
+	}
+
+	login, err := json.Marshal(map[string]string{
+		"username": string(secret.Data[core.BasicAuthUsernameKey]),
+		"password": string(secret.Data[core.BasicAuthPasswordKey]),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := callOperator(ctx, client, endpoint+"/login", "", login)
+	if err != nil {
+		return "", err
+	}
+
+	var token struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(resp, &token); err != nil {
+		return "", err
+	}
+
+	return token.Token, nil
+}
+
+// callOperator sends POST request to the operator HTTP server and returns the response body.
+func callOperator(ctx context.Context, client *http.Client, url, token string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Newf("unexpected HTTP status %d from \"%s\": %s", resp.StatusCode, url, string(data))
+	}
+
+	return data, nil
+}
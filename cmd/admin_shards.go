@@ -0,0 +1,169 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/topology"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+)
+
+func init() {
+	cmdAdmin.AddCommand(cmdShards)
+
+	cmdShards.AddCommand(cmdShardsZones)
+	cmdShardsZones.Flags().StringP(ArgDeploymentName, "d", "",
+		"necessary when more than one deployment exist within on namespace")
+}
+
+var cmdShards = &cobra.Command{
+	Use:   "shards",
+	Short: "Get shards details",
+	Run:   executeUsage,
+}
+
+var cmdShardsZones = &cobra.Command{
+	Use:   "zones",
+	Short: "List shards which replicas are not spread across zones",
+	Run:   cmdGetShardsZones,
+}
+
+func cmdGetShardsZones(cmd *cobra.Command, _ []string) {
+	deploymentName, _ := cmd.Flags().GetString(ArgDeploymentName)
+	ctx := getInterruptionContext()
+	d, certCA, auth, err := getDeploymentAndCredentials(ctx, deploymentName)
+	if err != nil {
+		logger.Err(err).Fatal("failed to create basic data for the connection")
+	}
+
+	if d.GetAcceptedSpec().GetMode() != api.DeploymentModeCluster {
+		logger.Fatal("shards zones do not work for the \"%s\" deployment \"%s\"", d.GetAcceptedSpec().GetMode(),
+			d.GetName())
+	}
+
+	client, ok := kclient.GetDefaultFactory().Client()
+	if !ok {
+		logger.Fatal("Client not initialised")
+	}
+
+	locations, err := getDBServerLocations(ctx, client.Kubernetes(), d)
+	if err != nil {
+		logger.Err(err).Fatal("failed to get zones of the DB servers")
+	}
+
+	zones := locations.Zones()
+
+	conn, err := getAgencyLeaderConnection(ctx, d, certCA, auth)
+	if err != nil {
+		logger.Err(err).Fatal("failed to get leader ID")
+	}
+
+	body, err := getAgencyState(ctx, conn)
+	if body != nil {
+		defer body.Close()
+	}
+	if err != nil {
+		logger.Err(err).Fatal("can not get state of the agency")
+	}
+
+	var roots agency.StateRoots
+	if err := json.NewDecoder(body).Decode(&roots); err != nil {
+		logger.Err(err).Fatal("can not parse state of the agency")
+	}
+
+	if len(roots) != 1 {
+		logger.Fatal("invalid state of the agency")
+	}
+
+	available := zones.Zones()
+	if len(available) < 2 {
+		logger.Fatal("DB servers are spread across %d zone(s), at least 2 zones are required", len(available))
+	}
+
+	violations := roots[0].Arango.GetShardsZoneViolations(zones)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DATABASE\tCOLLECTION\tSHARD\tSERVERS\tNODES\tZONES")
+	for _, v := range violations {
+		servers := make([]string, len(v.Servers))
+		for id, s := range v.Servers {
+			servers[id] = string(s)
+		}
+
+		nodes := locations.Nodes(v.Servers)
+		for id, n := range nodes {
+			if n == "" {
+				nodes[id] = "-"
+			}
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", v.Database, v.Collection, v.Shard,
+			strings.Join(servers, ","), strings.Join(nodes, ","), strings.Join(v.Zones, ","))
+	}
+	w.Flush()
+
+	fmt.Printf("\n%d shards are not spread across zones (%s)\n", len(violations), strings.Join(available, ","))
+}
+
+// getDBServerLocations returns nodes and zones of the DB servers based on the labels of the nodes on which they are running.
+func getDBServerLocations(ctx context.Context, kubeCli kubernetes.Interface, d api.ArangoDeployment) (topology.ServerLocations, error) {
+	return topology.GetServerLocations(d.Status.Members.DBServers, d.GetAcceptedSpec().Topology.GetLabel(),
+		func(m api.MemberStatus) (*core.Pod, error) {
+			ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+			defer cancel()
+
+			pod, err := kubeCli.CoreV1().Pods(d.GetNamespace()).Get(ctxChild, m.Pod.GetName(), meta.GetOptions{})
+			if err != nil {
+				if api.IsNotFound(err) {
+					return nil, nil
+				}
+				return nil, errors.WithMessage(err, fmt.Sprintf("failed to get pod %s", m.Pod.GetName()))
+			}
+
+			return pod, nil
+		},
+		func(_ api.MemberStatus, name string) (*core.Node, error) {
+			ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+			defer cancel()
+
+			node, err := kubeCli.CoreV1().Nodes().Get(ctxChild, name, meta.GetOptions{})
+			if err != nil {
+				return nil, errors.WithMessage(err, fmt.Sprintf("failed to get node %s", name))
+			}
+
+			return node, nil
+		})
+}
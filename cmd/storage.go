@@ -28,6 +28,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
 	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
 	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner/service"
 	"github.com/arangodb/kube-arangodb/pkg/util/constants"
@@ -46,7 +47,16 @@ var (
 	}
 
 	storageProvisioner struct {
-		port int
+		port       int
+		localPaths []string
+		backend    struct {
+			backendType string
+			fsType      string
+			lvm         struct {
+				volumeGroup string
+				thinPool    string
+			}
+		}
 	}
 )
 
@@ -56,6 +66,11 @@ func init() {
 
 	f := cmdStorageProvisioner.Flags()
 	f.IntVar(&storageProvisioner.port, "port", provisioner.DefaultPort, "Port to listen on")
+	f.StringArrayVar(&storageProvisioner.localPaths, "local-path", nil, "Local path in which volumes are provisioned")
+	f.StringVar(&storageProvisioner.backend.backendType, "backend", string(api.LocalStorageBackendDirectory), "Backend used to provision volumes (Directory, LVM, LoopFile)")
+	f.StringVar(&storageProvisioner.backend.fsType, "fs-type", api.DefaultLocalStorageFSType, "Filesystem created on the block volumes")
+	f.StringVar(&storageProvisioner.backend.lvm.volumeGroup, "lvm-volume-group", "", "Volume group containing the LVM thin pool")
+	f.StringVar(&storageProvisioner.backend.lvm.thinPool, "lvm-thin-pool", "", "LVM thin pool in which volumes are created")
 }
 
 // Run the provisioner
@@ -85,8 +100,17 @@ func cmdStorageProvisionerRun(cmd *cobra.Command, args []string) {
 // newProvisionerConfigAndDeps creates storage provisioner config & dependencies.
 func newProvisionerConfigAndDeps(nodeName string) service.Config {
 	cfg := service.Config{
-		Address:  net.JoinHostPort("0.0.0.0", strconv.Itoa(storageProvisioner.port)),
-		NodeName: nodeName,
+		Address:    net.JoinHostPort("0.0.0.0", strconv.Itoa(storageProvisioner.port)),
+		NodeName:   nodeName,
+		LocalPaths: storageProvisioner.localPaths,
+		Backend: service.BackendConfig{
+			Type:   api.LocalStorageBackendType(storageProvisioner.backend.backendType),
+			FSType: storageProvisioner.backend.fsType,
+			LVM: api.LocalStorageLVMSpec{
+				VolumeGroup: storageProvisioner.backend.lvm.volumeGroup,
+				ThinPool:    storageProvisioner.backend.lvm.thinPool,
+			},
+		},
 	}
 
 	return cfg
@@ -21,20 +21,46 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
 )
 
-func init() {
-	var deploymentName string
+const (
+	ArgTaskType    = "type"
+	ArgTaskMember  = "member"
+	ArgTaskWait    = "wait"
+	ArgTaskTimeout = "timeout"
+
+	taskStateCheckInterval = 5 * time.Second
+)
 
+func init() {
 	cmdMain.AddCommand(cmdTask)
 	cmdOps.AddCommand(cmdTask)
 
 	cmdTask.AddCommand(cmdTaskCreate)
-	cmdTaskCreate.Flags().StringVarP(&deploymentName, ArgDeploymentName, "d", "",
+	cmdTaskCreate.Flags().StringP(ArgDeploymentName, "d", "",
 		"Name of ArangoDeployment for which Task will be created - necessary when more than one deployment exist within one namespace")
+	cmdTaskCreate.Flags().StringP(ArgTaskType, "t", "", "Type of the task")
+	cmdTaskCreate.Flags().StringP(ArgTaskMember, "m", "", "ID of the member - required by the member tasks")
+	cmdTaskCreate.Flags().Bool(ArgTaskWait, false, "Wait until task is finished")
+	cmdTaskCreate.Flags().Duration(ArgTaskTimeout, time.Hour, "Timeout of the wait for the task")
 
 	cmdTask.AddCommand(cmdTaskState)
+	cmdTaskState.Flags().Bool(ArgTaskWait, false, "Wait until task is finished")
+	cmdTaskState.Flags().Duration(ArgTaskTimeout, time.Hour, "Timeout of the wait for the task")
 }
 
 var cmdTask = &cobra.Command{
@@ -45,20 +71,168 @@ var cmdTask = &cobra.Command{
 var cmdTaskCreate = &cobra.Command{
 	Use:   "create",
 	Short: "Create task",
-	Run:   taskCreate,
+	Long: "It creates the task for the deployment and prints its name on the stdout. Supported types: " +
+		strings.Join([]string{
+			string(api.ArangoTaskRestartMemberType),
+			string(api.ArangoTaskResignLeadershipType),
+			string(api.ArangoTaskCleanOutMemberType),
+			string(api.ArangoTaskReplaceMemberType),
+			string(api.ArangoTaskRotateJWTType),
+			string(api.ArangoTaskRotateTLSCAType),
+			string(api.ArangoTaskEnableMaintenanceType),
+		}, ", "),
+	Run: taskCreate,
 }
 
 var cmdTaskState = &cobra.Command{
-	Use:   "state",
+	Use:   "state [name]",
 	Short: "Get Task state",
 	Long:  "It prints the task current state on the stdout",
+	Args:  cobra.ExactArgs(1),
 	Run:   taskState,
 }
 
-func taskCreate(cmd *cobra.Command, args []string) {
-	logger.Info("TODO: create task")
+func taskCreate(cmd *cobra.Command, _ []string) {
+	deploymentName, _ := cmd.Flags().GetString(ArgDeploymentName)
+	taskType, _ := cmd.Flags().GetString(ArgTaskType)
+	memberID, _ := cmd.Flags().GetString(ArgTaskMember)
+
+	namespace := os.Getenv(constants.EnvOperatorPodNamespace)
+	if len(namespace) == 0 {
+		logger.Fatal("\"%s\" environment variable missing", constants.EnvOperatorPodNamespace)
+	}
+
+	t := api.ArangoTaskType(taskType)
+	if err := t.Validate(); err != nil {
+		logger.Err(err).Fatal("invalid task type")
+	}
+
+	spec := api.ArangoTaskSpec{
+		Type: t,
+	}
+
+	if t.IsMemberTask() {
+		if memberID == "" {
+			logger.Fatal("member ID is required by the %s task", t)
+		}
+
+		if err := spec.Details.Set(api.ArangoTaskMemberDetails{ID: memberID}); err != nil {
+			logger.Err(err).Fatal("failed to set task details")
+		}
+	}
+
+	ctx := getInterruptionContext()
+
+	d, err := getDeployment(ctx, namespace, deploymentName)
+	if err != nil {
+		logger.Err(err).Fatal("failed to get deployment")
+	}
+
+	client, ok := kclient.GetDefaultFactory().Client()
+	if !ok {
+		logger.Fatal("Client not initialised")
+	}
+
+	task := &api.ArangoTask{
+		ObjectMeta: meta.ObjectMeta{
+			GenerateName:    fmt.Sprintf("%s-%s-", d.GetName(), strings.ToLower(taskType)),
+			OwnerReferences: []meta.OwnerReference{d.AsOwner()},
+		},
+		Spec: spec,
+	}
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+
+	task, err = client.Arango().DatabaseV1().ArangoTasks(namespace).Create(ctxChild, task, meta.CreateOptions{})
+	if err != nil {
+		logger.Err(err).Fatal("failed to create task")
+	}
+
+	fmt.Println(task.GetName())
+
+	if wait, _ := cmd.Flags().GetBool(ArgTaskWait); wait {
+		timeout, _ := cmd.Flags().GetDuration(ArgTaskTimeout)
+		waitForTask(ctx, namespace, task.GetName(), timeout)
+	}
 }
 
 func taskState(cmd *cobra.Command, args []string) {
-	logger.Info("TODO: check task state")
+	namespace := os.Getenv(constants.EnvOperatorPodNamespace)
+	if len(namespace) == 0 {
+		logger.Fatal("\"%s\" environment variable missing", constants.EnvOperatorPodNamespace)
+	}
+
+	ctx := getInterruptionContext()
+
+	if wait, _ := cmd.Flags().GetBool(ArgTaskWait); wait {
+		timeout, _ := cmd.Flags().GetDuration(ArgTaskTimeout)
+		waitForTask(ctx, namespace, args[0], timeout)
+		return
+	}
+
+	task, err := getTask(ctx, namespace, args[0])
+	if err != nil {
+		logger.Err(err).Fatal("failed to get task")
+	}
+
+	printTaskState(task)
+}
+
+// waitForTask polls the task state until task is finished. It exits with error when task failed.
+func waitForTask(ctx context.Context, namespace, name string, timeout time.Duration) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		task, err := getTask(ctx, namespace, name)
+		if err != nil {
+			logger.Err(err).Fatal("failed to get task")
+		}
+
+		if task.Status.State.IsFinished() {
+			printTaskState(task)
+
+			if task.Status.State == api.ArangoTaskFailedState {
+				os.Exit(1)
+			}
+
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			logger.Fatal("task %s is not finished yet - operation timed out", name)
+		case <-time.After(taskStateCheckInterval):
+			logger.Info("Task %s is in %s state. Waiting...", name, task.Status.State)
+		}
+	}
+}
+
+func getTask(ctx context.Context, namespace, name string) (*api.ArangoTask, error) {
+	client, ok := kclient.GetDefaultFactory().Client()
+	if !ok {
+		return nil, errors.Newf("Client not initialised")
+	}
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+
+	return client.Arango().DatabaseV1().ArangoTasks(namespace).Get(ctxChild, name, meta.GetOptions{})
+}
+
+func printTaskState(task *api.ArangoTask) {
+	state := task.Status.State
+	if state == api.ArangoTaskUnknownState {
+		state = api.ArangoTaskPendingState
+	}
+
+	if m := task.Status.Message; m != "" {
+		fmt.Printf("%s: %s\n", state, m)
+		return
+	}
+
+	fmt.Println(state)
 }
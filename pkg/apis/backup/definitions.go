@@ -29,6 +29,10 @@ const (
 	ArangoBackupPolicyResourceKind   = "ArangoBackupPolicy"
 	ArangoBackupPolicyResourcePlural = "arangobackuppolicies"
 
+	ArangoRestoreCRDName        = ArangoRestoreResourcePlural + "." + ArangoBackupGroupName
+	ArangoRestoreResourceKind   = "ArangoRestore"
+	ArangoRestoreResourcePlural = "arangorestores"
+
 	ArangoBackupGroupName = "backup.arangodb.com"
 )
 
@@ -36,4 +40,6 @@ var (
 	ArangoBackupShortNames = []string{"arangobackup"}
 
 	ArangoBackupPolicyShortNames = []string{"arangobackuppolicy"}
+
+	ArangoRestoreShortNames = []string{"arangorestore"}
 )
@@ -58,9 +58,11 @@ func (a *ArangoBackupPolicy) NewBackup(d *deployment.ArangoDeployment) *ArangoBa
 		Deployment: ArangoBackupSpecDeployment{
 			Name: d.Name,
 		},
-		Upload:     a.Spec.BackupTemplate.Upload.DeepCopy(),
-		Options:    a.Spec.BackupTemplate.Options.DeepCopy(),
-		PolicyName: &policyName,
+		Upload:       a.Spec.BackupTemplate.Upload.DeepCopy(),
+		Options:      a.Spec.BackupTemplate.Options.DeepCopy(),
+		Hooks:        a.Spec.BackupTemplate.Hooks.DeepCopy(),
+		Verification: a.Spec.BackupTemplate.Verification.DeepCopy(),
+		PolicyName:   &policyName,
 	}
 
 	return &ArangoBackup{
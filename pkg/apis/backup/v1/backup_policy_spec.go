@@ -21,19 +21,75 @@
 package v1
 
 import (
+	"time"
+
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+type ArangoBackupPolicyConcurrencyPolicy string
+
+const (
+	// ArangoBackupPolicyConcurrencyPolicyAllow allows to create backup while previous one is still in progress
+	ArangoBackupPolicyConcurrencyPolicyAllow ArangoBackupPolicyConcurrencyPolicy = "Allow"
+	// ArangoBackupPolicyConcurrencyPolicyForbid skips backup creation while previous one is still in progress
+	ArangoBackupPolicyConcurrencyPolicyForbid ArangoBackupPolicyConcurrencyPolicy = "Forbid"
+	// ArangoBackupPolicyConcurrencyPolicyReplace removes backups which are still in progress and creates new one
+	ArangoBackupPolicyConcurrencyPolicyReplace ArangoBackupPolicyConcurrencyPolicy = "Replace"
+)
+
+func (a *ArangoBackupPolicyConcurrencyPolicy) Get() ArangoBackupPolicyConcurrencyPolicy {
+	if a == nil {
+		return ArangoBackupPolicyConcurrencyPolicyAllow
+	}
+
+	return *a
+}
+
 type ArangoBackupPolicySpec struct {
 	Schedule string `json:"schedule"`
 
+	// Suspend stops creation of the new backups. Scheduled runs are skipped while policy is suspended.
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// StartingDeadlineSeconds defines deadline in seconds for starting the backups if scheduled time is missed.
+	// Missed runs are skipped once deadline is exceeded.
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// ConcurrencyPolicy defines how to handle backups of the deployment which are still in progress. Default to Allow
+	ConcurrencyPolicy *ArangoBackupPolicyConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
 	DeploymentSelector *meta.LabelSelector `json:"selector,omitempty"`
 
 	BackupTemplate ArangoBackupTemplate `json:"template"`
+
+	// Retention defines rules used to remove old backups created by this policy
+	Retention *ArangoBackupPolicyRetention `json:"retention,omitempty"`
 }
 
 type ArangoBackupTemplate struct {
 	Options *ArangoBackupSpecOptions `json:"options,omitempty"`
 
 	Upload *ArangoBackupSpecOperation `json:"upload,omitempty"`
+
+	// Hooks executed before and after the backup
+	Hooks *ArangoBackupSpecHooks `json:"hooks,omitempty"`
+
+	// Verification enables restore of the uploaded backup into the scratch deployment
+	Verification *ArangoBackupSpecVerification `json:"verification,omitempty"`
+}
+
+func (a *ArangoBackupPolicySpec) GetSuspend() bool {
+	if a == nil || a.Suspend == nil {
+		return false
+	}
+
+	return *a.Suspend
+}
+
+func (a *ArangoBackupPolicySpec) GetStartingDeadline() time.Duration {
+	if a == nil || a.StartingDeadlineSeconds == nil {
+		return 0
+	}
+
+	return time.Duration(*a.StartingDeadlineSeconds) * time.Second
 }
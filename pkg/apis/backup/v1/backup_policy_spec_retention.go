@@ -0,0 +1,112 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// ArangoBackupPolicyRetention defines which backups created by the policy are kept.
+// Backup is kept when it matches at least one of the rules. Rules are applied separately for each deployment.
+type ArangoBackupPolicyRetention struct {
+	// KeepLast defines number of the most recent backups which are kept
+	KeepLast *int `json:"keepLast,omitempty"`
+	// MaxAge defines how long backups are kept
+	MaxAge *meta.Duration `json:"maxAge,omitempty"`
+	// KeepDaily defines number of the most recent days for which the newest backup of the day is kept
+	KeepDaily *int `json:"keepDaily,omitempty"`
+	// KeepWeekly defines number of the most recent weeks for which the newest backup of the week is kept
+	KeepWeekly *int `json:"keepWeekly,omitempty"`
+}
+
+// IsEnabled returns true if at least one retention rule is defined
+func (a *ArangoBackupPolicyRetention) IsEnabled() bool {
+	if a == nil {
+		return false
+	}
+
+	return a.KeepLast != nil || a.MaxAge != nil || a.KeepDaily != nil || a.KeepWeekly != nil
+}
+
+func (a *ArangoBackupPolicyRetention) GetKeepLast() int {
+	if a == nil || a.KeepLast == nil {
+		return 0
+	}
+
+	return *a.KeepLast
+}
+
+func (a *ArangoBackupPolicyRetention) GetMaxAge() time.Duration {
+	if a == nil || a.MaxAge == nil {
+		return 0
+	}
+
+	return a.MaxAge.Duration
+}
+
+func (a *ArangoBackupPolicyRetention) GetKeepDaily() int {
+	if a == nil || a.KeepDaily == nil {
+		return 0
+	}
+
+	return *a.KeepDaily
+}
+
+func (a *ArangoBackupPolicyRetention) GetKeepWeekly() int {
+	if a == nil || a.KeepWeekly == nil {
+		return 0
+	}
+
+	return *a.KeepWeekly
+}
+
+func (a *ArangoBackupPolicyRetention) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	if a.KeepLast != nil && *a.KeepLast < 0 {
+		return errors.Newf("retention keepLast can not be negative")
+	}
+
+	if a.MaxAge != nil && a.MaxAge.Duration < 0 {
+		return errors.Newf("retention maxAge can not be negative")
+	}
+
+	if a.KeepDaily != nil && *a.KeepDaily < 0 {
+		return errors.Newf("retention keepDaily can not be negative")
+	}
+
+	if a.KeepWeekly != nil && *a.KeepWeekly < 0 {
+		return errors.Newf("retention keepWeekly can not be negative")
+	}
+
+	if a.IsEnabled() && a.GetKeepLast() == 0 && a.GetMaxAge() == 0 && a.GetKeepDaily() == 0 && a.GetKeepWeekly() == 0 {
+		// Such retention would remove all backups of the policy
+		return errors.Newf("retention needs to keep at least one backup, one of keepLast, maxAge, keepDaily or keepWeekly needs to be greater than 0")
+	}
+
+	return nil
+}
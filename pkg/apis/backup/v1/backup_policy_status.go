@@ -27,4 +27,35 @@ import (
 type ArangoBackupPolicyStatus struct {
 	Scheduled meta.Time `json:"scheduled,omitempty"`
 	Message   string    `json:"message,omitempty"`
+
+	// Deployments keeps per deployment details of the backups created by the policy
+	Deployments map[string]ArangoBackupPolicyDeploymentStatus `json:"deployments,omitempty"`
+
+	// Retention keeps details about backups removed by the retention rules
+	Retention *ArangoBackupPolicyRetentionStatus `json:"retention,omitempty"`
+}
+
+type ArangoBackupPolicyRetentionStatus struct {
+	// Pruned keeps number of backups removed by the retention rules
+	Pruned int `json:"pruned,omitempty"`
+	// LastPruned keeps time of the last backup removal
+	LastPruned *meta.Time `json:"lastPruned,omitempty"`
+}
+
+type ArangoBackupPolicyDeploymentStatus struct {
+	// LastScheduled keeps the last time when backup was created for the deployment
+	LastScheduled *meta.Time `json:"lastScheduled,omitempty"`
+	// LastSuccessful keeps the most recent backup in Ready state
+	LastSuccessful *ArangoBackupPolicyBackupStatus `json:"lastSuccessful,omitempty"`
+	// LastFailed keeps the most recent backup in Failed state
+	LastFailed *ArangoBackupPolicyBackupStatus `json:"lastFailed,omitempty"`
+}
+
+type ArangoBackupPolicyBackupStatus struct {
+	// Name of the ArangoBackup
+	Name string `json:"name"`
+	// Time when the ArangoBackup was created
+	Time meta.Time `json:"time"`
+	// Message of the ArangoBackup state
+	Message string `json:"message,omitempty"`
 }
@@ -43,5 +43,40 @@ func (a *ArangoBackupPolicySpec) Validate() error {
 		return errors.Newf("invalid schedule format")
 	}
 
+	if a.StartingDeadlineSeconds != nil && *a.StartingDeadlineSeconds < 0 {
+		return errors.Newf("startingDeadlineSeconds can not be negative")
+	}
+
+	if err := a.ConcurrencyPolicy.Validate(); err != nil {
+		return err
+	}
+
+	if err := a.Retention.Validate(); err != nil {
+		return err
+	}
+
+	if err := a.BackupTemplate.Hooks.Validate(); err != nil {
+		return err
+	}
+
+	if a.BackupTemplate.Verification != nil {
+		if a.BackupTemplate.Upload == nil {
+			return errors.Newf("verification requires upload to be defined")
+		}
+
+		if err := a.BackupTemplate.Verification.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid verification")
+		}
+	}
+
 	return nil
 }
+
+func (a *ArangoBackupPolicyConcurrencyPolicy) Validate() error {
+	switch v := a.Get(); v {
+	case ArangoBackupPolicyConcurrencyPolicyAllow, ArangoBackupPolicyConcurrencyPolicyForbid, ArangoBackupPolicyConcurrencyPolicyReplace:
+		return nil
+	default:
+		return errors.Newf("unknown concurrencyPolicy: %s", v)
+	}
+}
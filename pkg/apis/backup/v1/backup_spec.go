@@ -35,6 +35,12 @@ type ArangoBackupSpec struct {
 	PolicyName *string `json:"policyName,omitempty"`
 
 	Backoff *ArangoBackupSpecBackOff `json:"backoff,omitempty"`
+
+	// Hooks executed before and after the backup
+	Hooks *ArangoBackupSpecHooks `json:"hooks,omitempty"`
+
+	// Verification enables restore of the uploaded backup into the scratch deployment
+	Verification *ArangoBackupSpecVerification `json:"verification,omitempty"`
 }
 
 type ArangoBackupSpecDeployment struct {
@@ -0,0 +1,240 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	batch "k8s.io/api/batch/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// ArangoBackupHookDefaultTimeout defines default timeout of the hook execution
+	ArangoBackupHookDefaultTimeout = time.Minute
+)
+
+type ArangoBackupHookFailurePolicy string
+
+const (
+	// ArangoBackupHookFailurePolicyFail marks backup as Failed if pre-backup hook fails
+	ArangoBackupHookFailurePolicyFail ArangoBackupHookFailurePolicy = "Fail"
+	// ArangoBackupHookFailurePolicyIgnore continues backup if hook fails
+	ArangoBackupHookFailurePolicyIgnore ArangoBackupHookFailurePolicy = "Ignore"
+)
+
+func (a *ArangoBackupHookFailurePolicy) Get() ArangoBackupHookFailurePolicy {
+	if a == nil {
+		return ArangoBackupHookFailurePolicyFail
+	}
+
+	return *a
+}
+
+func (a *ArangoBackupHookFailurePolicy) Validate() error {
+	switch v := a.Get(); v {
+	case ArangoBackupHookFailurePolicyFail, ArangoBackupHookFailurePolicyIgnore:
+		return nil
+	default:
+		return errors.Newf("unknown failurePolicy: %s", v)
+	}
+}
+
+type ArangoBackupSpecHooks struct {
+	// Pre hooks are executed before the backup is created
+	Pre []ArangoBackupHook `json:"pre,omitempty"`
+
+	// Post hooks are executed after the backup is Ready or Failed
+	Post []ArangoBackupHook `json:"post,omitempty"`
+}
+
+func (a *ArangoBackupSpecHooks) GetPre() []ArangoBackupHook {
+	if a == nil {
+		return nil
+	}
+
+	return a.Pre
+}
+
+func (a *ArangoBackupSpecHooks) GetPost() []ArangoBackupHook {
+	if a == nil {
+		return nil
+	}
+
+	return a.Post
+}
+
+func (a *ArangoBackupSpecHooks) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	if err := validateHooks(a.Pre); err != nil {
+		return errors.Wrapf(err, "invalid pre hooks")
+	}
+
+	if err := validateHooks(a.Post); err != nil {
+		return errors.Wrapf(err, "invalid post hooks")
+	}
+
+	return nil
+}
+
+func validateHooks(hooks []ArangoBackupHook) error {
+	names := map[string]bool{}
+
+	for _, hook := range hooks {
+		if err := hook.Validate(); err != nil {
+			return err
+		}
+
+		if names[hook.Name] {
+			return errors.Newf("hook %s is defined more than once", hook.Name)
+		}
+
+		names[hook.Name] = true
+	}
+
+	return nil
+}
+
+// ArangoBackupHook defines action executed before or after the backup.
+// Exactly one of JobTemplate or HTTP needs to be set.
+type ArangoBackupHook struct {
+	Name string `json:"name"`
+
+	// JobTemplate defines Job which is executed as a hook
+	JobTemplate *batch.JobSpec `json:"jobTemplate,omitempty"`
+
+	// HTTP defines HTTP request which is executed as a hook
+	HTTP *ArangoBackupHookHTTP `json:"http,omitempty"`
+
+	// TimeoutSeconds defines timeout of the hook execution. Default to 60
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// FailurePolicy defines how hook failure is handled. Default to Fail
+	FailurePolicy *ArangoBackupHookFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+func (a ArangoBackupHook) GetTimeout() time.Duration {
+	if a.TimeoutSeconds == nil || *a.TimeoutSeconds == 0 {
+		return ArangoBackupHookDefaultTimeout
+	}
+
+	return time.Duration(*a.TimeoutSeconds) * time.Second
+}
+
+func (a ArangoBackupHook) Validate() error {
+	if a.Name == "" {
+		return errors.Newf("hook name can not be empty")
+	}
+
+	if (a.JobTemplate == nil) == (a.HTTP == nil) {
+		return errors.Newf("hook %s needs to define exactly one of jobTemplate or http", a.Name)
+	}
+
+	if a.HTTP != nil {
+		if err := a.HTTP.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid hook %s", a.Name)
+		}
+	}
+
+	if a.TimeoutSeconds != nil && *a.TimeoutSeconds < 0 {
+		return errors.Newf("hook %s timeoutSeconds can not be negative", a.Name)
+	}
+
+	return a.FailurePolicy.Validate()
+}
+
+type ArangoBackupHookHTTP struct {
+	// URL of the request
+	URL string `json:"url"`
+
+	// Method of the request. Default to POST
+	Method string `json:"method,omitempty"`
+
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// AllowExternal allows URLs which do not point to the Kubernetes Service. Default to false
+	AllowExternal *bool `json:"allowExternal,omitempty"`
+}
+
+func (a *ArangoBackupHookHTTP) GetAllowExternal() bool {
+	if a == nil || a.AllowExternal == nil {
+		return false
+	}
+
+	return *a.AllowExternal
+}
+
+func (a *ArangoBackupHookHTTP) GetMethod() string {
+	if a == nil || a.Method == "" {
+		return "POST"
+	}
+
+	return a.Method
+}
+
+func (a *ArangoBackupHookHTTP) Validate() error {
+	if a.URL == "" {
+		return errors.Newf("url can not be empty")
+	}
+
+	u, err := url.ParseRequestURI(a.URL)
+	if err != nil {
+		return errors.Newf("url is invalid: %s", err.Error())
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Newf("url scheme %s is not supported", u.Scheme)
+	}
+
+	if !a.GetAllowExternal() && !isServiceHost(u.Hostname()) {
+		return errors.Newf("url host %s is not a Kubernetes Service, set allowExternal to use it", u.Hostname())
+	}
+
+	return nil
+}
+
+// isServiceHost returns true if host is a name of the Kubernetes Service,
+// in the short form (<service>) or in the DNS form (<service>.<namespace>.svc[.<cluster domain>])
+func isServiceHost(host string) bool {
+	if net.ParseIP(host) != nil {
+		return false
+	}
+
+	labels := strings.Split(host, ".")
+	for _, label := range labels {
+		if label == "" {
+			return false
+		}
+	}
+
+	if len(labels) == 1 {
+		return true
+	}
+
+	return len(labels) >= 3 && labels[2] == "svc"
+}
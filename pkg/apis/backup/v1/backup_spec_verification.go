@@ -0,0 +1,103 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"time"
+
+	batch "k8s.io/api/batch/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// ArangoBackupVerificationDefaultTimeout defines default timeout of the backup verification
+	ArangoBackupVerificationDefaultTimeout = 6 * time.Hour
+)
+
+type ArangoBackupSpecVerification struct {
+	// Collections defines collections which needs to be present in the restored deployment
+	Collections []ArangoBackupVerificationCollection `json:"collections,omitempty"`
+
+	// JobTemplate defines ArangoJob executed against the restored deployment, e.g. to run an AQL query
+	JobTemplate *batch.JobSpec `json:"jobTemplate,omitempty"`
+
+	// TimeoutSeconds defines timeout of the whole verification, including deployment creation and restore
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+}
+
+func (a *ArangoBackupSpecVerification) GetTimeout() time.Duration {
+	if a == nil || a.TimeoutSeconds == nil {
+		return ArangoBackupVerificationDefaultTimeout
+	}
+
+	return time.Duration(*a.TimeoutSeconds) * time.Second
+}
+
+func (a *ArangoBackupSpecVerification) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	if a.TimeoutSeconds != nil && *a.TimeoutSeconds <= 0 {
+		return errors.Newf("timeoutSeconds needs to be greater than 0")
+	}
+
+	for id, c := range a.Collections {
+		if err := c.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid collection %d", id)
+		}
+	}
+
+	return nil
+}
+
+type ArangoBackupVerificationCollection struct {
+	Database   string `json:"database"`
+	Collection string `json:"collection"`
+
+	// MinCount defines minimal number of documents in the collection
+	MinCount *int64 `json:"minCount,omitempty"`
+}
+
+func (a ArangoBackupVerificationCollection) GetMinCount() int64 {
+	if a.MinCount == nil {
+		return 0
+	}
+
+	return *a.MinCount
+}
+
+func (a ArangoBackupVerificationCollection) Validate() error {
+	if a.Database == "" {
+		return errors.Newf("database can not be empty")
+	}
+
+	if a.Collection == "" {
+		return errors.Newf("collection can not be empty")
+	}
+
+	if a.GetMinCount() < 0 {
+		return errors.Newf("minCount can not be negative")
+	}
+
+	return nil
+}
@@ -91,6 +91,22 @@ func (a *ArangoBackupState) Equal(b *ArangoBackupState) bool {
 type ArangoBackupProgress struct {
 	JobID    string `json:"jobID"`
 	Progress string `json:"progress"`
+
+	// StartTime is the time when transfer progress has been observed for the first time
+	StartTime *meta.Time `json:"startTime,omitempty"`
+
+	// BytesDone is the estimated number of transferred bytes. Estimation is based on the backup size and transferred files ratio
+	BytesDone uint64 `json:"bytesDone,omitempty"`
+	// BytesTotal is the size of the backup
+	BytesTotal uint64 `json:"bytesTotal,omitempty"`
+	// BytesPerSecond is the average throughput of the transfer since StartTime
+	BytesPerSecond uint64 `json:"bytesPerSecond,omitempty"`
+
+	// EstimatedCompletionTime is the estimated time of the transfer completion
+	EstimatedCompletionTime *meta.Time `json:"estimatedCompletionTime,omitempty"`
+
+	// DBServers keeps transfer progress of each DBServer
+	DBServers ArangoBackupDBServerProgressList `json:"dbservers,omitempty"`
 }
 
 func (a *ArangoBackupProgress) Equal(b *ArangoBackupProgress) bool {
@@ -103,5 +119,82 @@ func (a *ArangoBackupProgress) Equal(b *ArangoBackupProgress) bool {
 	}
 
 	return a.JobID == b.JobID &&
-		a.Progress == b.Progress
+		a.Progress == b.Progress &&
+		a.StartTime.Equal(b.StartTime) &&
+		a.BytesDone == b.BytesDone &&
+		a.BytesTotal == b.BytesTotal &&
+		a.BytesPerSecond == b.BytesPerSecond &&
+		a.EstimatedCompletionTime.Equal(b.EstimatedCompletionTime) &&
+		a.DBServers.Equal(b.DBServers)
+}
+
+func (a *ArangoBackupProgress) GetDBServers() ArangoBackupDBServerProgressList {
+	if a == nil {
+		return nil
+	}
+
+	return a.DBServers
+}
+
+type ArangoBackupDBServerProgressList []ArangoBackupDBServerProgress
+
+func (a ArangoBackupDBServerProgressList) Get(id string) (ArangoBackupDBServerProgress, bool) {
+	for _, p := range a {
+		if p.ID == id {
+			return p, true
+		}
+	}
+
+	return ArangoBackupDBServerProgress{}, false
+}
+
+func (a ArangoBackupDBServerProgressList) Equal(b ArangoBackupDBServerProgressList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for id := range a {
+		if !a[id].Equal(&b[id]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type ArangoBackupDBServerProgress struct {
+	// ID of the DBServer
+	ID string `json:"id"`
+	// Status of the transfer reported by the DBServer
+	Status string `json:"status,omitempty"`
+	// Message keeps error reported by the DBServer
+	Message string `json:"message,omitempty"`
+
+	// FilesDone is the number of already transferred files
+	FilesDone int `json:"filesDone"`
+	// FilesTotal is the number of files to transfer
+	FilesTotal int `json:"filesTotal"`
+
+	// LastUpdateTime is the time when the number of transferred files changed for the last time
+	LastUpdateTime *meta.Time `json:"lastUpdateTime,omitempty"`
+	// EstimatedCompletionTime is the estimated time of the transfer completion on the DBServer
+	EstimatedCompletionTime *meta.Time `json:"estimatedCompletionTime,omitempty"`
+}
+
+func (a *ArangoBackupDBServerProgress) Equal(b *ArangoBackupDBServerProgress) bool {
+	if a == b {
+		return true
+	}
+
+	if a == nil && b != nil || a != nil && b == nil {
+		return false
+	}
+
+	return a.ID == b.ID &&
+		a.Status == b.Status &&
+		a.Message == b.Message &&
+		a.FilesDone == b.FilesDone &&
+		a.FilesTotal == b.FilesTotal &&
+		a.LastUpdateTime.Equal(b.LastUpdateTime) &&
+		a.EstimatedCompletionTime.Equal(b.EstimatedCompletionTime)
 }
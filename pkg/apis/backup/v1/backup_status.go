@@ -23,6 +23,7 @@ package v1
 import (
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	deployment "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
 )
 
@@ -33,6 +34,11 @@ type ArangoBackupStatus struct {
 	Backup            *ArangoBackupDetails       `json:"backup,omitempty"`
 	Available         bool                       `json:"available"`
 	Backoff           *ArangoBackupStatusBackOff `json:"backoff,omitempty"`
+	Hooks             *ArangoBackupStatusHooks   `json:"hooks,omitempty"`
+
+	// Verification keeps state of the backup verification
+	Verification *ArangoBackupStatusVerification `json:"verification,omitempty"`
+	Conditions   deployment.ConditionList        `json:"conditions,omitempty"`
 }
 
 func (a *ArangoBackupStatus) Equal(b *ArangoBackupStatus) bool {
@@ -46,7 +52,10 @@ func (a *ArangoBackupStatus) Equal(b *ArangoBackupStatus) bool {
 
 	return a.ArangoBackupState.Equal(&b.ArangoBackupState) &&
 		a.Backup.Equal(b.Backup) &&
-		a.Available == b.Available
+		a.Available == b.Available &&
+		a.Hooks.Equal(b.Hooks) &&
+		a.Verification.Equal(b.Verification) &&
+		a.Conditions.Equal(b.Conditions)
 }
 
 type ArangoBackupDetails struct {
@@ -0,0 +1,114 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ArangoBackupHookState string
+
+const (
+	ArangoBackupHookStateRunning   ArangoBackupHookState = "Running"
+	ArangoBackupHookStateSucceeded ArangoBackupHookState = "Succeeded"
+	ArangoBackupHookStateFailed    ArangoBackupHookState = "Failed"
+)
+
+// IsFinal returns true if hook will not change its state anymore
+func (a ArangoBackupHookState) IsFinal() bool {
+	return a == ArangoBackupHookStateSucceeded || a == ArangoBackupHookStateFailed
+}
+
+type ArangoBackupStatusHooks struct {
+	Pre  ArangoBackupHookStatusList `json:"pre,omitempty"`
+	Post ArangoBackupHookStatusList `json:"post,omitempty"`
+}
+
+func (a *ArangoBackupStatusHooks) GetPre() ArangoBackupHookStatusList {
+	if a == nil {
+		return nil
+	}
+
+	return a.Pre
+}
+
+func (a *ArangoBackupStatusHooks) GetPost() ArangoBackupHookStatusList {
+	if a == nil {
+		return nil
+	}
+
+	return a.Post
+}
+
+func (a *ArangoBackupStatusHooks) Equal(b *ArangoBackupStatusHooks) bool {
+	if a == b {
+		return true
+	}
+
+	if a == nil && b != nil || a != nil && b == nil {
+		return false
+	}
+
+	return a.Pre.Equal(b.Pre) && a.Post.Equal(b.Post)
+}
+
+type ArangoBackupHookStatusList []ArangoBackupHookStatus
+
+// Get returns status of the hook with given name
+func (a ArangoBackupHookStatusList) Get(name string) (ArangoBackupHookStatus, bool) {
+	for _, s := range a {
+		if s.Name == name {
+			return s, true
+		}
+	}
+
+	return ArangoBackupHookStatus{}, false
+}
+
+func (a ArangoBackupHookStatusList) Equal(b ArangoBackupHookStatusList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for id := range a {
+		if !a[id].Equal(b[id]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type ArangoBackupHookStatus struct {
+	Name           string                `json:"name"`
+	State          ArangoBackupHookState `json:"state"`
+	StartTime      *meta.Time            `json:"startTime,omitempty"`
+	CompletionTime *meta.Time            `json:"completionTime,omitempty"`
+	Message        string                `json:"message,omitempty"`
+}
+
+func (a ArangoBackupHookStatus) Equal(b ArangoBackupHookStatus) bool {
+	return a.Name == b.Name &&
+		a.State == b.State &&
+		a.StartTime.Equal(b.StartTime) &&
+		a.CompletionTime.Equal(b.CompletionTime) &&
+		a.Message == b.Message
+}
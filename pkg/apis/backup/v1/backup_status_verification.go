@@ -0,0 +1,83 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	deployment "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+const (
+	// ConditionTypeBackupVerified indicates that the backup has been restored in the scratch deployment and checks passed.
+	ConditionTypeBackupVerified deployment.ConditionType = "Verified"
+)
+
+type ArangoBackupVerificationPhase string
+
+const (
+	// ArangoBackupVerificationPhaseDeploying scratch deployment is created and backup is downloaded into it
+	ArangoBackupVerificationPhaseDeploying ArangoBackupVerificationPhase = "Deploying"
+	// ArangoBackupVerificationPhaseRestoring backup is restored in the scratch deployment
+	ArangoBackupVerificationPhaseRestoring ArangoBackupVerificationPhase = "Restoring"
+	// ArangoBackupVerificationPhaseChecking checks are executed against the scratch deployment
+	ArangoBackupVerificationPhaseChecking ArangoBackupVerificationPhase = "Checking"
+	// ArangoBackupVerificationPhaseCleaningUp scratch deployment is removed
+	ArangoBackupVerificationPhaseCleaningUp ArangoBackupVerificationPhase = "CleaningUp"
+	// ArangoBackupVerificationPhaseCompleted verification is done, result is kept in the Verified condition
+	ArangoBackupVerificationPhaseCompleted ArangoBackupVerificationPhase = "Completed"
+)
+
+type ArangoBackupStatusVerification struct {
+	Phase ArangoBackupVerificationPhase `json:"phase,omitempty"`
+
+	// Deployment is the name of the scratch deployment
+	Deployment string `json:"deployment,omitempty"`
+
+	StartTime      *meta.Time `json:"startTime,omitempty"`
+	CompletionTime *meta.Time `json:"completionTime,omitempty"`
+
+	Message string `json:"message,omitempty"`
+}
+
+func (a *ArangoBackupStatusVerification) GetPhase() ArangoBackupVerificationPhase {
+	if a == nil {
+		return ""
+	}
+
+	return a.Phase
+}
+
+func (a *ArangoBackupStatusVerification) Equal(b *ArangoBackupStatusVerification) bool {
+	if a == b {
+		return true
+	}
+
+	if a == nil && b != nil || a != nil && b == nil {
+		return false
+	}
+
+	return a.Phase == b.Phase &&
+		a.Deployment == b.Deployment &&
+		a.StartTime.Equal(b.StartTime) &&
+		a.CompletionTime.Equal(b.CompletionTime) &&
+		a.Message == b.Message
+}
@@ -51,6 +51,20 @@ func (a *ArangoBackupSpec) Validate() error {
 		}
 	}
 
+	if err := a.Hooks.Validate(); err != nil {
+		return err
+	}
+
+	if a.Verification != nil {
+		if a.Upload == nil {
+			return errors.Newf("verification requires upload to be defined")
+		}
+
+		if err := a.Verification.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid verification")
+		}
+	}
+
 	return nil
 }
 
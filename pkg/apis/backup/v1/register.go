@@ -51,6 +51,8 @@ func addKnownTypes(s *runtime.Scheme) error {
 		&ArangoBackupList{},
 		&ArangoBackupPolicy{},
 		&ArangoBackupPolicyList{},
+		&ArangoRestore{},
+		&ArangoRestoreList{},
 	)
 	meta.AddToGroupVersion(s, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,58 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	deployment "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+const (
+	// ConditionTypeRestoreVersionCompatible indicates that the backup version can be restored on the deployment version.
+	ConditionTypeRestoreVersionCompatible deployment.ConditionType = "VersionCompatible"
+	// ConditionTypeRestoreDBServersMatch indicates that the backup was taken with the same number of DBServers.
+	ConditionTypeRestoreDBServersMatch deployment.ConditionType = "DBServersMatch"
+	// ConditionTypeRestoreEncryptionKeysMatch indicates that the encryption keys of the backup are known to the deployment.
+	ConditionTypeRestoreEncryptionKeysMatch deployment.ConditionType = "EncryptionKeysMatch"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArangoRestoreList is a list of ArangoDB restores.
+type ArangoRestoreList struct {
+	meta.TypeMeta `json:",inline"`
+	meta.ListMeta `json:"metadata,omitempty"`
+
+	Items []ArangoRestore `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArangoRestore contains definition and status of the ArangoDB Restore.
+type ArangoRestore struct {
+	meta.TypeMeta   `json:",inline"`
+	meta.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ArangoRestoreSpec   `json:"spec"`
+	Status ArangoRestoreStatus `json:"status"`
+}
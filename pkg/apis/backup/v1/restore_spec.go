@@ -0,0 +1,39 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+type ArangoRestoreSpec struct {
+	// Deployment which should be restored
+	Deployment ArangoBackupSpecDeployment `json:"deployment,omitempty"`
+
+	// Backup which should be restored
+	Backup ArangoRestoreSpecBackup `json:"backup,omitempty"`
+
+	// Reason for the restore
+	Reason string `json:"reason,omitempty"`
+
+	// RequestedBy keeps information about the requester of the restore
+	RequestedBy string `json:"requestedBy,omitempty"`
+}
+
+type ArangoRestoreSpecBackup struct {
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,64 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	deployment "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+type ArangoRestorePhase string
+
+const (
+	ArangoRestorePhaseNone      ArangoRestorePhase = ""
+	ArangoRestorePhasePending   ArangoRestorePhase = "Pending"
+	ArangoRestorePhaseRestoring ArangoRestorePhase = "Restoring"
+	ArangoRestorePhaseRestored  ArangoRestorePhase = "Restored"
+	ArangoRestorePhaseFailed    ArangoRestorePhase = "Failed"
+)
+
+// IsFinal returns true if restore will not change its phase anymore
+func (a ArangoRestorePhase) IsFinal() bool {
+	return a == ArangoRestorePhaseRestored || a == ArangoRestorePhaseFailed
+}
+
+// IsPending returns true if restore is waiting for execution
+func (a ArangoRestorePhase) IsPending() bool {
+	return a == ArangoRestorePhaseNone || a == ArangoRestorePhasePending
+}
+
+// ArangoRestoreStatus contains the status part of
+// an ArangoRestore.
+type ArangoRestoreStatus struct {
+	Phase ArangoRestorePhase `json:"phase,omitempty"`
+
+	// BackupID keeps ID of the restored backup
+	BackupID string `json:"backupID,omitempty"`
+
+	StartTime      *meta.Time `json:"startTime,omitempty"`
+	CompletionTime *meta.Time `json:"completionTime,omitempty"`
+
+	Message string `json:"message,omitempty"`
+
+	// Conditions keeps results of the pre-flight checks
+	Conditions deployment.ConditionList `json:"conditions,omitempty"`
+}
@@ -26,7 +26,9 @@
 package v1
 
 import (
+	deploymentv1 "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	sharedv1 "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -59,6 +61,52 @@ func (in *ArangoBackup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupDBServerProgress) DeepCopyInto(out *ArangoBackupDBServerProgress) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EstimatedCompletionTime != nil {
+		in, out := &in.EstimatedCompletionTime, &out.EstimatedCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupDBServerProgress.
+func (in *ArangoBackupDBServerProgress) DeepCopy() *ArangoBackupDBServerProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupDBServerProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ArangoBackupDBServerProgressList) DeepCopyInto(out *ArangoBackupDBServerProgressList) {
+	{
+		in := &in
+		*out = make(ArangoBackupDBServerProgressList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupDBServerProgressList.
+func (in ArangoBackupDBServerProgressList) DeepCopy() ArangoBackupDBServerProgressList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupDBServerProgressList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupDetails) DeepCopyInto(out *ArangoBackupDetails) {
 	*out = *in
@@ -101,6 +149,116 @@ func (in *ArangoBackupDetails) DeepCopy() *ArangoBackupDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupHook) DeepCopyInto(out *ArangoBackupHook) {
+	*out = *in
+	if in.JobTemplate != nil {
+		in, out := &in.JobTemplate, &out.JobTemplate
+		*out = new(batchv1.JobSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(ArangoBackupHookHTTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(ArangoBackupHookFailurePolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupHook.
+func (in *ArangoBackupHook) DeepCopy() *ArangoBackupHook {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupHookHTTP) DeepCopyInto(out *ArangoBackupHookHTTP) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AllowExternal != nil {
+		in, out := &in.AllowExternal, &out.AllowExternal
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupHookHTTP.
+func (in *ArangoBackupHookHTTP) DeepCopy() *ArangoBackupHookHTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupHookHTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupHookStatus) DeepCopyInto(out *ArangoBackupHookStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupHookStatus.
+func (in *ArangoBackupHookStatus) DeepCopy() *ArangoBackupHookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupHookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ArangoBackupHookStatusList) DeepCopyInto(out *ArangoBackupHookStatusList) {
+	{
+		in := &in
+		*out = make(ArangoBackupHookStatusList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupHookStatusList.
+func (in ArangoBackupHookStatusList) DeepCopy() ArangoBackupHookStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupHookStatusList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupList) DeepCopyInto(out *ArangoBackupList) {
 	*out = *in
@@ -162,6 +320,53 @@ func (in *ArangoBackupPolicy) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupPolicyBackupStatus) DeepCopyInto(out *ArangoBackupPolicyBackupStatus) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupPolicyBackupStatus.
+func (in *ArangoBackupPolicyBackupStatus) DeepCopy() *ArangoBackupPolicyBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupPolicyBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupPolicyDeploymentStatus) DeepCopyInto(out *ArangoBackupPolicyDeploymentStatus) {
+	*out = *in
+	if in.LastScheduled != nil {
+		in, out := &in.LastScheduled, &out.LastScheduled
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessful != nil {
+		in, out := &in.LastSuccessful, &out.LastSuccessful
+		*out = new(ArangoBackupPolicyBackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastFailed != nil {
+		in, out := &in.LastFailed, &out.LastFailed
+		*out = new(ArangoBackupPolicyBackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupPolicyDeploymentStatus.
+func (in *ArangoBackupPolicyDeploymentStatus) DeepCopy() *ArangoBackupPolicyDeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupPolicyDeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupPolicyList) DeepCopyInto(out *ArangoBackupPolicyList) {
 	*out = *in
@@ -195,15 +400,91 @@ func (in *ArangoBackupPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupPolicyRetention) DeepCopyInto(out *ArangoBackupPolicyRetention) {
+	*out = *in
+	if in.KeepLast != nil {
+		in, out := &in.KeepLast, &out.KeepLast
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.KeepDaily != nil {
+		in, out := &in.KeepDaily, &out.KeepDaily
+		*out = new(int)
+		**out = **in
+	}
+	if in.KeepWeekly != nil {
+		in, out := &in.KeepWeekly, &out.KeepWeekly
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupPolicyRetention.
+func (in *ArangoBackupPolicyRetention) DeepCopy() *ArangoBackupPolicyRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupPolicyRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupPolicyRetentionStatus) DeepCopyInto(out *ArangoBackupPolicyRetentionStatus) {
+	*out = *in
+	if in.LastPruned != nil {
+		in, out := &in.LastPruned, &out.LastPruned
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupPolicyRetentionStatus.
+func (in *ArangoBackupPolicyRetentionStatus) DeepCopy() *ArangoBackupPolicyRetentionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupPolicyRetentionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupPolicySpec) DeepCopyInto(out *ArangoBackupPolicySpec) {
 	*out = *in
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ConcurrencyPolicy != nil {
+		in, out := &in.ConcurrencyPolicy, &out.ConcurrencyPolicy
+		*out = new(ArangoBackupPolicyConcurrencyPolicy)
+		**out = **in
+	}
 	if in.DeploymentSelector != nil {
 		in, out := &in.DeploymentSelector, &out.DeploymentSelector
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
 	in.BackupTemplate.DeepCopyInto(&out.BackupTemplate)
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ArangoBackupPolicyRetention)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -221,6 +502,18 @@ func (in *ArangoBackupPolicySpec) DeepCopy() *ArangoBackupPolicySpec {
 func (in *ArangoBackupPolicyStatus) DeepCopyInto(out *ArangoBackupPolicyStatus) {
 	*out = *in
 	in.Scheduled.DeepCopyInto(&out.Scheduled)
+	if in.Deployments != nil {
+		in, out := &in.Deployments, &out.Deployments
+		*out = make(map[string]ArangoBackupPolicyDeploymentStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ArangoBackupPolicyRetentionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -237,6 +530,21 @@ func (in *ArangoBackupPolicyStatus) DeepCopy() *ArangoBackupPolicyStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupProgress) DeepCopyInto(out *ArangoBackupProgress) {
 	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EstimatedCompletionTime != nil {
+		in, out := &in.EstimatedCompletionTime, &out.EstimatedCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DBServers != nil {
+		in, out := &in.DBServers, &out.DBServers
+		*out = make(ArangoBackupDBServerProgressList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -279,6 +587,16 @@ func (in *ArangoBackupSpec) DeepCopyInto(out *ArangoBackupSpec) {
 		*out = new(ArangoBackupSpecBackOff)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(ArangoBackupSpecHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ArangoBackupSpecVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -356,6 +674,36 @@ func (in *ArangoBackupSpecDownload) DeepCopy() *ArangoBackupSpecDownload {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupSpecHooks) DeepCopyInto(out *ArangoBackupSpecHooks) {
+	*out = *in
+	if in.Pre != nil {
+		in, out := &in.Pre, &out.Pre
+		*out = make([]ArangoBackupHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Post != nil {
+		in, out := &in.Post, &out.Post
+		*out = make([]ArangoBackupHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupSpecHooks.
+func (in *ArangoBackupSpecHooks) DeepCopy() *ArangoBackupSpecHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupSpecHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupSpecOperation) DeepCopyInto(out *ArangoBackupSpecOperation) {
 	*out = *in
@@ -398,6 +746,38 @@ func (in *ArangoBackupSpecOptions) DeepCopy() *ArangoBackupSpecOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupSpecVerification) DeepCopyInto(out *ArangoBackupSpecVerification) {
+	*out = *in
+	if in.Collections != nil {
+		in, out := &in.Collections, &out.Collections
+		*out = make([]ArangoBackupVerificationCollection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.JobTemplate != nil {
+		in, out := &in.JobTemplate, &out.JobTemplate
+		*out = (*in).DeepCopy()
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupSpecVerification.
+func (in *ArangoBackupSpecVerification) DeepCopy() *ArangoBackupSpecVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupSpecVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupState) DeepCopyInto(out *ArangoBackupState) {
 	*out = *in
@@ -405,7 +785,7 @@ func (in *ArangoBackupState) DeepCopyInto(out *ArangoBackupState) {
 	if in.Progress != nil {
 		in, out := &in.Progress, &out.Progress
 		*out = new(ArangoBackupProgress)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -434,6 +814,23 @@ func (in *ArangoBackupStatus) DeepCopyInto(out *ArangoBackupStatus) {
 		*out = new(ArangoBackupStatusBackOff)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(ArangoBackupStatusHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ArangoBackupStatusVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(deploymentv1.ConditionList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -464,6 +861,60 @@ func (in *ArangoBackupStatusBackOff) DeepCopy() *ArangoBackupStatusBackOff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupStatusHooks) DeepCopyInto(out *ArangoBackupStatusHooks) {
+	*out = *in
+	if in.Pre != nil {
+		in, out := &in.Pre, &out.Pre
+		*out = make(ArangoBackupHookStatusList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Post != nil {
+		in, out := &in.Post, &out.Post
+		*out = make(ArangoBackupHookStatusList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupStatusHooks.
+func (in *ArangoBackupStatusHooks) DeepCopy() *ArangoBackupStatusHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupStatusHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupStatusVerification) DeepCopyInto(out *ArangoBackupStatusVerification) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupStatusVerification.
+func (in *ArangoBackupStatusVerification) DeepCopy() *ArangoBackupStatusVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupStatusVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupTemplate) DeepCopyInto(out *ArangoBackupTemplate) {
 	*out = *in
@@ -477,6 +928,16 @@ func (in *ArangoBackupTemplate) DeepCopyInto(out *ArangoBackupTemplate) {
 		*out = new(ArangoBackupSpecOperation)
 		**out = **in
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(ArangoBackupSpecHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ArangoBackupSpecVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -489,3 +950,150 @@ func (in *ArangoBackupTemplate) DeepCopy() *ArangoBackupTemplate {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupVerificationCollection) DeepCopyInto(out *ArangoBackupVerificationCollection) {
+	*out = *in
+	if in.MinCount != nil {
+		in, out := &in.MinCount, &out.MinCount
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupVerificationCollection.
+func (in *ArangoBackupVerificationCollection) DeepCopy() *ArangoBackupVerificationCollection {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupVerificationCollection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRestore) DeepCopyInto(out *ArangoRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRestore.
+func (in *ArangoRestore) DeepCopy() *ArangoRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRestoreList) DeepCopyInto(out *ArangoRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArangoRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRestoreList.
+func (in *ArangoRestoreList) DeepCopy() *ArangoRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRestoreSpec) DeepCopyInto(out *ArangoRestoreSpec) {
+	*out = *in
+	out.Deployment = in.Deployment
+	out.Backup = in.Backup
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRestoreSpec.
+func (in *ArangoRestoreSpec) DeepCopy() *ArangoRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRestoreSpecBackup) DeepCopyInto(out *ArangoRestoreSpecBackup) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRestoreSpecBackup.
+func (in *ArangoRestoreSpecBackup) DeepCopy() *ArangoRestoreSpecBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRestoreSpecBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoRestoreStatus) DeepCopyInto(out *ArangoRestoreStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(deploymentv1.ConditionList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoRestoreStatus.
+func (in *ArangoRestoreStatus) DeepCopy() *ArangoRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
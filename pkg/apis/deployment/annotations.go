@@ -27,4 +27,7 @@ const (
 	ArangoDeploymentPodReplaceAnnotation     = ArangoDeploymentAnnotationPrefix + "/replace"
 	ArangoDeploymentPodDeleteNow             = ArangoDeploymentAnnotationPrefix + "/delete_now"
 	ArangoDeploymentPlanCleanAnnotation      = "plan." + ArangoDeploymentAnnotationPrefix + "/clean"
+	ArangoDeploymentPlanCancelAnnotation     = "plan." + ArangoDeploymentAnnotationPrefix + "/cancel"
+	ArangoDeploymentPausedAnnotation         = ArangoDeploymentAnnotationPrefix + "/paused"
+	ArangoDeploymentPVCAutoGrownAnnotation   = ArangoDeploymentAnnotationPrefix + "/auto-grown-size"
 )
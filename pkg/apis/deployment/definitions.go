@@ -37,6 +37,10 @@ const (
 	ArangoTaskResourceKind   = "ArangoTask"
 	ArangoTaskResourcePlural = "arangotasks"
 
+	ArangoMemberGroupCRDName        = ArangoMemberGroupResourcePlural + "." + ArangoDeploymentGroupName
+	ArangoMemberGroupResourceKind   = "ArangoMemberGroup"
+	ArangoMemberGroupResourcePlural = "arangomembergroups"
+
 	ArangoDeploymentGroupName = "database.arangodb.com"
 )
 
@@ -0,0 +1,52 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArangoMemberGroupList is a list of ArangoDB member groups.
+type ArangoMemberGroupList struct {
+	meta.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	meta.ListMeta `json:"metadata,omitempty"`
+	Items         []ArangoMemberGroup `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArangoMemberGroup exposes the scale of the server group of the ArangoDeployment.
+type ArangoMemberGroup struct {
+	meta.TypeMeta   `json:",inline"`
+	meta.ObjectMeta `json:"metadata,omitempty"`
+	Spec            ArangoMemberGroupSpec   `json:"spec,omitempty"`
+	Status          ArangoMemberGroupStatus `json:"status,omitempty"`
+}
+
+// IsSpecObserved returns true if the current spec has been applied to the ArangoDeployment
+func (a *ArangoMemberGroup) IsSpecObserved() bool {
+	return a.Status.ObservedGeneration == a.GetGeneration()
+}
@@ -0,0 +1,47 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ArangoMemberGroupName returns name of the ArangoMemberGroup for the server group of the deployment
+func ArangoMemberGroupName(deploymentName string, group ServerGroup) string {
+	return fmt.Sprintf("%s-%s", deploymentName, group.AsRole())
+}
+
+// IsScalableServerGroup returns true if the server group can be scaled with the ArangoMemberGroup
+func IsScalableServerGroup(group ServerGroup) bool {
+	return group == ServerGroupCoordinators || group == ServerGroupDBServers
+}
+
+type ArangoMemberGroupSpec struct {
+	// Group keeps the server group which is scaled
+	Group         ServerGroup `json:"group,omitempty"`
+	DeploymentUID types.UID   `json:"deploymentUID,omitempty"`
+
+	// Replicas define the expected number of members in the group.
+	// Value is propagated to the count of the group in the ArangoDeployment within the minCount and maxCount bounds.
+	Replicas int `json:"replicas"`
+}
@@ -0,0 +1,30 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+type ArangoMemberGroupStatus struct {
+	// Replicas keeps the current number of members in the group
+	Replicas int `json:"replicas"`
+	// Selector keeps the label selector of the Pods in the group, used by the HorizontalPodAutoscaler
+	Selector string `json:"selector,omitempty"`
+	// ObservedGeneration keeps the generation of the spec which is applied to the ArangoDeployment
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
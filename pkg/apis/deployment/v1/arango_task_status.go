@@ -30,9 +30,17 @@ const (
 	ArangoTaskFailedState  ArangoTaskState = "Failed"
 )
 
+// IsFinished returns true if task reached final state
+func (a ArangoTaskState) IsFinished() bool {
+	return a == ArangoTaskSuccessState || a == ArangoTaskFailedState
+}
+
 type ArangoTaskStatus struct {
 	AcceptedSpec *ArangoTaskSpec `json:"acceptedSpec,omitempty"`
 
 	State   ArangoTaskState   `json:"state,omitempty"`
 	Details ArangoTaskDetails `json:"details,omitempty"`
+
+	// Message keeps the reason of the task failure
+	Message string `json:"message,omitempty"`
 }
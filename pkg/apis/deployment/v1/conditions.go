@@ -70,6 +70,8 @@ const (
 	ConditionTypeMarkedToRemove ConditionType = "MarkedToRemove"
 	// ConditionTypeUpgradeFailed indicates that upgrade failed
 	ConditionTypeUpgradeFailed ConditionType = "UpgradeFailed"
+	// ConditionTypeUpgradeCanary indicates that the member is upgraded as a canary
+	ConditionTypeUpgradeCanary ConditionType = "UpgradeCanary"
 
 	// ConditionTypeMemberMaintenanceMode indicates that Maintenance is enabled on particular member
 	ConditionTypeMemberMaintenanceMode ConditionType = "MemberMaintenanceMode"
@@ -111,6 +113,20 @@ const (
 
 	// ConditionTypeMaintenance indicates that maintenance is enabled on cluster
 	ConditionTypeMaintenance ConditionType = "Maintenance"
+
+	// ConditionTypePendingMaintenanceWindow indicates that actions restarting or shutting down members are deferred
+	// until the next maintenance window
+	ConditionTypePendingMaintenanceWindow ConditionType = "PendingMaintenanceWindow"
+
+	// ConditionTypePaused indicates that reconciliation of the deployment is paused
+	ConditionTypePaused ConditionType = "Paused"
+
+	// ConditionTypeShardsZoneDiverse indicates that replicas of all shards are spread across zones
+	ConditionTypeShardsZoneDiverse ConditionType = "ShardsZoneDiverse"
+
+	// ConditionTypeScaleDownBlocked indicates that scale down of DBServers is blocked
+	// because remaining DBServers do not have enough free space for the data of the removed member
+	ConditionTypeScaleDownBlocked ConditionType = "ScaleDownBlocked"
 )
 
 // Condition represents one current condition of a deployment or deployment member.
@@ -21,6 +21,8 @@
 package v1
 
 import (
+	"strconv"
+
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
@@ -113,6 +115,22 @@ func (d *ArangoDeployment) foreachServerGroup(cb ServerGroupFunc, spec Deploymen
 	return nil
 }
 
+// IsReconciliationPaused returns true when reconciliation of the deployment is paused by the spec field or annotation
+func IsReconciliationPaused(obj meta.Object, spec DeploymentSpec) bool {
+	if spec.IsPaused() {
+		return true
+	}
+
+	v, ok := obj.GetAnnotations()[deployment.ArangoDeploymentPausedAnnotation]
+	if !ok {
+		return false
+	}
+
+	// Only true value pauses reconciliation, invalid values are ignored
+	paused, err := strconv.ParseBool(v)
+	return err == nil && paused
+}
+
 // IsAccepted checks if accepted version match current version in spec
 func (d ArangoDeployment) IsAccepted() (bool, error) {
 	if as := d.Status.AcceptedSpecVersion; as != nil {
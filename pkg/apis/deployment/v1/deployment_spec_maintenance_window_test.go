@@ -0,0 +1,65 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_MaintenanceWindows(t *testing.T) {
+	windows := MaintenanceWindows{
+		{
+			Schedule: "0 2 * * *",
+			Duration: meta.Duration{Duration: 2 * time.Hour},
+		},
+	}
+
+	require.NoError(t, windows.Validate())
+
+	t.Run("Open", func(t *testing.T) {
+		require.True(t, windows.IsOpen(time.Date(2022, 5, 10, 3, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("Closed", func(t *testing.T) {
+		require.False(t, windows.IsOpen(time.Date(2022, 5, 10, 5, 0, 0, 0, time.UTC)))
+		require.False(t, windows.IsOpen(time.Date(2022, 5, 10, 1, 59, 0, 0, time.UTC)))
+	})
+
+	t.Run("Next", func(t *testing.T) {
+		next, ok := windows.Next(time.Date(2022, 5, 10, 5, 0, 0, 0, time.UTC))
+		require.True(t, ok)
+		require.Equal(t, time.Date(2022, 5, 11, 2, 0, 0, 0, time.UTC), next)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		var empty MaintenanceWindows
+		require.True(t, empty.IsOpen(time.Now()))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		require.Error(t, MaintenanceWindows{{Schedule: "invalid", Duration: meta.Duration{Duration: time.Hour}}}.Validate())
+		require.Error(t, MaintenanceWindows{{Schedule: "0 2 * * *"}}.Validate())
+	})
+}
@@ -25,7 +25,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
 	"github.com/arangodb/kube-arangodb/pkg/handlers/utils"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 )
@@ -199,3 +201,24 @@ func TestDeploymentSpec_GetCoreContainers(t *testing.T) {
 		})
 	}
 }
+
+func TestIsReconciliationPaused(t *testing.T) {
+	assert.False(t, IsReconciliationPaused(&meta.ObjectMeta{}, DeploymentSpec{}))
+	assert.False(t, IsReconciliationPaused(&meta.ObjectMeta{}, DeploymentSpec{Paused: util.NewBool(false)}))
+	assert.True(t, IsReconciliationPaused(&meta.ObjectMeta{}, DeploymentSpec{Paused: util.NewBool(true)}))
+	assert.True(t, IsReconciliationPaused(&meta.ObjectMeta{
+		Annotations: map[string]string{
+			deployment.ArangoDeploymentPausedAnnotation: "true",
+		},
+	}, DeploymentSpec{}))
+	assert.False(t, IsReconciliationPaused(&meta.ObjectMeta{
+		Annotations: map[string]string{
+			deployment.ArangoDeploymentPausedAnnotation: "false",
+		},
+	}, DeploymentSpec{}))
+	assert.False(t, IsReconciliationPaused(&meta.ObjectMeta{
+		Annotations: map[string]string{
+			deployment.ArangoDeploymentPausedAnnotation: "",
+		},
+	}, DeploymentSpec{}))
+}
@@ -55,6 +55,9 @@ type DeploymentStatus struct {
 	// Image that is currently being used when new pods are created
 	CurrentImage *ImageInfo `json:"current-image,omitempty"`
 
+	// UpgradeCanary keeps state of the canary upgrade
+	UpgradeCanary *DeploymentUpgradeCanaryStatus `json:"upgradeCanary,omitempty"`
+
 	// Members holds the status for all members in all server groups
 	Members DeploymentStatusMembers `json:"members"`
 
@@ -70,6 +73,9 @@ type DeploymentStatus struct {
 	// ResourcesPlan to update this deployment. Executed before plan, after highPlan
 	ResourcesPlan Plan `json:"resourcesPlan,omitempty"`
 
+	// PlanHistory keeps the latest actions executed by the operator
+	PlanHistory PlanHistory `json:"planHistory,omitempty"`
+
 	// AcceptedSpec contains the last specification that was accepted by the operator.
 	AcceptedSpec *DeploymentSpec `json:"accepted-spec,omitempty"`
 
@@ -115,11 +121,13 @@ func (ds *DeploymentStatus) Equal(other DeploymentStatus) bool {
 		ds.Images.Equal(other.Images) &&
 		ds.Restore.Equal(other.Restore) &&
 		ds.CurrentImage.Equal(other.CurrentImage) &&
+		ds.UpgradeCanary.Equal(other.UpgradeCanary) &&
 		ds.Members.Equal(other.Members) &&
 		ds.Conditions.Equal(other.Conditions) &&
 		ds.Plan.Equal(other.Plan) &&
 		ds.HighPriorityPlan.Equal(other.HighPriorityPlan) &&
 		ds.ResourcesPlan.Equal(other.ResourcesPlan) &&
+		ds.PlanHistory.Equal(other.PlanHistory) &&
 		util.CompareStringPointers(ds.AcceptedSpecVersion, other.AcceptedSpecVersion) &&
 		ds.AcceptedSpec.Equal(other.AcceptedSpec) &&
 		ds.SecretHashes.Equal(other.SecretHashes) &&
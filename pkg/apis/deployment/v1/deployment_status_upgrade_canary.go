@@ -0,0 +1,89 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeploymentUpgradeCanaryPhase define phase of the canary upgrade
+type DeploymentUpgradeCanaryPhase string
+
+const (
+	// DeploymentUpgradeCanaryPhaseUpgrading canary members are being upgraded
+	DeploymentUpgradeCanaryPhaseUpgrading DeploymentUpgradeCanaryPhase = "Upgrading"
+	// DeploymentUpgradeCanaryPhaseSoaking canary members are upgraded and observed
+	DeploymentUpgradeCanaryPhaseSoaking DeploymentUpgradeCanaryPhase = "Soaking"
+	// DeploymentUpgradeCanaryPhaseSucceeded canary members are healthy, upgrade continues with remaining members
+	DeploymentUpgradeCanaryPhaseSucceeded DeploymentUpgradeCanaryPhase = "Succeeded"
+	// DeploymentUpgradeCanaryPhaseFailed canary failed, upgrade is halted
+	DeploymentUpgradeCanaryPhaseFailed DeploymentUpgradeCanaryPhase = "Failed"
+	// DeploymentUpgradeCanaryPhaseRolledBack canary failed and canary members were rolled back to the previous image
+	DeploymentUpgradeCanaryPhaseRolledBack DeploymentUpgradeCanaryPhase = "RolledBack"
+)
+
+// IsFinished returns true if canary is not in progress anymore
+func (d DeploymentUpgradeCanaryPhase) IsFinished() bool {
+	switch d {
+	case DeploymentUpgradeCanaryPhaseSucceeded, DeploymentUpgradeCanaryPhaseFailed, DeploymentUpgradeCanaryPhaseRolledBack:
+		return true
+	default:
+		return false
+	}
+}
+
+// DeploymentUpgradeCanaryStatus keeps state of the canary upgrade
+type DeploymentUpgradeCanaryStatus struct {
+	// Image is the image to which deployment is upgraded
+	Image string `json:"image"`
+	// FromImage keeps image used before the upgrade
+	FromImage *ImageInfo `json:"fromImage,omitempty"`
+	// Phase of the canary upgrade
+	Phase DeploymentUpgradeCanaryPhase `json:"phase,omitempty"`
+	// Message keeps details of the current phase
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime keeps time of the last phase change
+	LastTransitionTime meta.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// GetPhase returns phase of the canary upgrade for the given image. Empty phase is returned if canary was not started for the image.
+func (d *DeploymentUpgradeCanaryStatus) GetPhase(image string) DeploymentUpgradeCanaryPhase {
+	if d == nil || d.Image != image {
+		return ""
+	}
+
+	return d.Phase
+}
+
+// Equal checks for equality
+func (d *DeploymentUpgradeCanaryStatus) Equal(other *DeploymentUpgradeCanaryStatus) bool {
+	if d == nil && other == nil {
+		return true
+	} else if d == nil || other == nil {
+		return false
+	}
+
+	return d.Image == other.Image &&
+		d.FromImage.Equal(other.FromImage) &&
+		d.Phase == other.Phase &&
+		d.Message == other.Message &&
+		d.LastTransitionTime.Equal(&other.LastTransitionTime)
+}
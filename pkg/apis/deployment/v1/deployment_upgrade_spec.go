@@ -20,9 +20,55 @@
 
 package v1
 
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// DeploymentUpgradeStrategy define how members are upgraded to the new version
+type DeploymentUpgradeStrategy string
+
+const (
+	// DeploymentUpgradeStrategySequential upgrades all members one by one
+	DeploymentUpgradeStrategySequential DeploymentUpgradeStrategy = "Sequential"
+	// DeploymentUpgradeStrategyCanary upgrades one member of each group first and continues only if canary members are healthy after soak period
+	DeploymentUpgradeStrategyCanary DeploymentUpgradeStrategy = "Canary"
+)
+
+// Get returns current or default value of DeploymentUpgradeStrategy
+func (d *DeploymentUpgradeStrategy) Get() DeploymentUpgradeStrategy {
+	if d == nil {
+		return DeploymentUpgradeStrategySequential
+	}
+
+	return *d
+}
+
+// Validate validates DeploymentUpgradeStrategy
+func (d *DeploymentUpgradeStrategy) Validate() error {
+	switch v := d.Get(); v {
+	case DeploymentUpgradeStrategySequential, DeploymentUpgradeStrategyCanary:
+		return nil
+	default:
+		return errors.Newf("Unknown upgrade strategy: %s", v)
+	}
+}
+
+const (
+	// DefaultUpgradeCanarySoakPeriod is the default time for which canary members are observed
+	DefaultUpgradeCanarySoakPeriod = 5 * time.Minute
+)
+
 type DeploymentUpgradeSpec struct {
 	// Flag specify if upgrade should be auto-injected, even if is not required (in case of stuck)
 	AutoUpgrade bool `json:"autoUpgrade"`
+	// Strategy define how members are upgraded to the new version. Defaults to Sequential
+	Strategy *DeploymentUpgradeStrategy `json:"strategy,omitempty"`
+	// Canary define settings of the Canary upgrade strategy
+	Canary *DeploymentUpgradeCanarySpec `json:"canary,omitempty"`
 }
 
 func (d *DeploymentUpgradeSpec) Get() DeploymentUpgradeSpec {
@@ -32,3 +78,74 @@ func (d *DeploymentUpgradeSpec) Get() DeploymentUpgradeSpec {
 
 	return *d
 }
+
+// Validate validates DeploymentUpgradeSpec
+func (d *DeploymentUpgradeSpec) Validate() error {
+	if d == nil {
+		return nil
+	}
+
+	if err := d.Strategy.Validate(); err != nil {
+		return errors.Wrapf(err, "strategy")
+	}
+
+	if err := d.Canary.Validate(); err != nil {
+		return errors.Wrapf(err, "canary")
+	}
+
+	return nil
+}
+
+// DeploymentUpgradeCanarySpec define settings of the Canary upgrade strategy
+type DeploymentUpgradeCanarySpec struct {
+	// SoakPeriod define how long canary members are observed before the remaining members are upgraded. Defaults to 5m
+	SoakPeriod *meta.Duration `json:"soakPeriod,omitempty"`
+	// MaxRestarts define how many restarts of the canary member are tolerated during soak period. Defaults to 0
+	MaxRestarts *int `json:"maxRestarts,omitempty"`
+	// Rollback enables rollback of the canary members to the previous image when canary fails. Used only for patch-level upgrades
+	Rollback *bool `json:"rollback,omitempty"`
+}
+
+// GetSoakPeriod returns soak period or default one
+func (d *DeploymentUpgradeCanarySpec) GetSoakPeriod() time.Duration {
+	if d == nil || d.SoakPeriod == nil {
+		return DefaultUpgradeCanarySoakPeriod
+	}
+
+	return d.SoakPeriod.Duration
+}
+
+// GetMaxRestarts returns number of tolerated restarts of the canary member
+func (d *DeploymentUpgradeCanarySpec) GetMaxRestarts() int {
+	if d == nil || d.MaxRestarts == nil {
+		return 0
+	}
+
+	return *d.MaxRestarts
+}
+
+// GetRollback returns true if rollback of the canary members is enabled
+func (d *DeploymentUpgradeCanarySpec) GetRollback() bool {
+	if d == nil || d.Rollback == nil {
+		return false
+	}
+
+	return *d.Rollback
+}
+
+// Validate validates DeploymentUpgradeCanarySpec
+func (d *DeploymentUpgradeCanarySpec) Validate() error {
+	if d == nil {
+		return nil
+	}
+
+	if d.GetSoakPeriod() < 0 {
+		return errors.Newf("soakPeriod cannot be negative")
+	}
+
+	if d.GetMaxRestarts() < 0 {
+		return errors.Newf("maxRestarts cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+// MemberDrainStatus keeps progress of the DBServer clean out
+type MemberDrainStatus struct {
+	// StartTime defines when clean out of the member has been started
+	StartTime meta.Time `json:"startTime"`
+	// InitialShards defines number of shard replicas placed on the member when clean out has been started
+	InitialShards int `json:"initialShards"`
+	// RemainingShards defines number of shard replicas which are still placed on the member
+	RemainingShards int `json:"remainingShards"`
+	// DataBytes defines estimated size of the data which needs to be moved, if known
+	DataBytes int64 `json:"dataBytes,omitempty"`
+}
+
+// GetProgress returns progress of the clean out in percent
+func (m *MemberDrainStatus) GetProgress() int {
+	if m == nil {
+		return 0
+	}
+
+	if m.InitialShards <= 0 || m.RemainingShards <= 0 {
+		return 100
+	}
+
+	if m.RemainingShards >= m.InitialShards {
+		return 0
+	}
+
+	return (m.InitialShards - m.RemainingShards) * 100 / m.InitialShards
+}
+
+func (m *MemberDrainStatus) Equal(other *MemberDrainStatus) bool {
+	if m == nil && other == nil {
+		return true
+	} else if m == nil || other == nil {
+		return false
+	}
+
+	return util.TimeCompareEqual(m.StartTime, other.StartTime) &&
+		m.InitialShards == other.InitialShards &&
+		m.RemainingShards == other.RemainingShards &&
+		m.DataBytes == other.DataBytes
+}
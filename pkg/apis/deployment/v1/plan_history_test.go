@@ -0,0 +1,57 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PlanHistory_Append(t *testing.T) {
+	var h PlanHistory
+
+	for i := 0; i < PlanHistoryLimit+10; i++ {
+		a := NewAction(ActionTypeRotateMember, ServerGroupDBServers, fmt.Sprintf("member-%d", i%2), "rotation")
+		h = h.Append(NewPlanHistoryEntry(a, "normal", PlanHistoryResultSucceeded, nil))
+	}
+
+	require.Len(t, h, PlanHistoryLimit)
+	require.Equal(t, "member-0", h[0].MemberID)
+	require.Equal(t, "member-1", h[len(h)-1].MemberID)
+	require.Len(t, h.FilterByMember("member-1"), PlanHistoryLimit/2)
+	require.True(t, h.Equal(h.Append()))
+}
+
+func Test_PlanHistory_Entry(t *testing.T) {
+	a := NewAction(ActionTypeCleanOutMember, ServerGroupDBServers, "id", "scale down")
+
+	e := NewPlanHistoryEntry(a, "high", PlanHistoryResultFailed, fmt.Errorf("cleanout failed"))
+
+	require.Equal(t, a.ID, e.ID)
+	require.Equal(t, ActionTypeCleanOutMember, e.Type)
+	require.Equal(t, "high", e.Priority)
+	require.Equal(t, "scale down", e.Reason)
+	require.Nil(t, e.StartTime)
+	require.Equal(t, PlanHistoryResultFailed, e.Result)
+	require.Equal(t, "cleanout failed", e.Error)
+}
@@ -68,6 +68,9 @@ func (a *ArangoDeploymentRebalancerReadersSpec) IsCountEnabled() bool {
 
 type ArangoDeploymentRebalancerOptimizersSpec struct {
 	Leader *bool `json:"leader,omitempty"`
+
+	// Zones enables moves of the shard replicas which are not spread across zones
+	Zones *bool `json:"zones,omitempty"`
 }
 
 func (a *ArangoDeploymentRebalancerOptimizersSpec) IsLeaderEnabled() bool {
@@ -77,3 +80,11 @@ func (a *ArangoDeploymentRebalancerOptimizersSpec) IsLeaderEnabled() bool {
 
 	return *a.Leader
 }
+
+func (a *ArangoDeploymentRebalancerOptimizersSpec) IsZonesEnabled() bool {
+	if a == nil || a.Zones == nil {
+		return false
+	}
+
+	return *a.Zones
+}
@@ -55,6 +55,8 @@ func addKnownTypes(s *runtime.Scheme) error {
 		&ArangoClusterSynchronizationList{},
 		&ArangoTask{},
 		&ArangoTaskList{},
+		&ArangoMemberGroup{},
+		&ArangoMemberGroupList{},
 	)
 	meta.AddToGroupVersion(s, SchemeGroupVersion)
 	return nil
@@ -24,6 +24,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/arangodb/kube-arangodb/pkg/util"
 )
@@ -130,3 +132,85 @@ func TestServerGroupSpecValidateArgs(t *testing.T) {
 	assert.Error(t, ServerGroupSpec{Count: util.NewInt(1), Args: []string{"--master.endpoint=http://something"}}.Validate(ServerGroupSyncMasters, true, DeploymentModeCluster, EnvironmentDevelopment))
 	assert.Error(t, ServerGroupSpec{Count: util.NewInt(1), Args: []string{"--mq.type=strange"}}.Validate(ServerGroupSyncMasters, true, DeploymentModeCluster, EnvironmentDevelopment))
 }
+
+func TestServerGroupSpecValidateTopologySpreadConstraints(t *testing.T) {
+	valid := core.TopologySpreadConstraint{
+		MaxSkew:           1,
+		TopologyKey:       "topology.kubernetes.io/zone",
+		WhenUnsatisfiable: core.DoNotSchedule,
+	}
+
+	spec := func(c ...core.TopologySpreadConstraint) ServerGroupSpec {
+		return ServerGroupSpec{Count: util.NewInt(3), TopologySpreadConstraints: c}
+	}
+
+	// Valid
+	assert.Nil(t, spec().Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Nil(t, spec(valid).Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	// Invalid
+	noSkew := valid
+	noSkew.MaxSkew = 0
+	assert.Error(t, spec(noSkew).Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	noKey := valid
+	noKey.TopologyKey = ""
+	assert.Error(t, spec(valid, noKey).Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	noMode := valid
+	noMode.WhenUnsatisfiable = ""
+	assert.Error(t, spec(noMode).Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+}
+
+func TestServerGroupSpecValidateAutoscaling(t *testing.T) {
+	spec := func(a *ServerGroupAutoscalingSpec) ServerGroupSpec {
+		return ServerGroupSpec{Count: util.NewInt(3), Autoscaling: a}
+	}
+
+	// Valid
+	assert.Nil(t, spec(nil).Validate(ServerGroupCoordinators, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Nil(t, spec(&ServerGroupAutoscalingSpec{TargetClientConnections: util.NewInt(100)}).Validate(ServerGroupCoordinators, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Nil(t, spec(&ServerGroupAutoscalingSpec{Enabled: util.NewBool(false)}).Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	// Invalid
+	assert.Error(t, spec(&ServerGroupAutoscalingSpec{TargetClientConnections: util.NewInt(100)}).Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Error(t, spec(&ServerGroupAutoscalingSpec{}).Validate(ServerGroupCoordinators, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Error(t, spec(&ServerGroupAutoscalingSpec{TargetRequestsInProgress: util.NewInt(0)}).Validate(ServerGroupCoordinators, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Error(t, spec(&ServerGroupAutoscalingSpec{
+		TargetRequestsInProgress: util.NewInt(10),
+		ScaleDown:                &ServerGroupAutoscalingBehaviour{CooldownSeconds: util.NewInt(-1)},
+	}).Validate(ServerGroupCoordinators, true, DeploymentModeCluster, EnvironmentDevelopment))
+}
+
+func TestServerGroupSpecValidateVolumeAutoGrow(t *testing.T) {
+	spec := func(a *ServerGroupVolumeAutoGrowSpec) ServerGroupSpec {
+		return ServerGroupSpec{Count: util.NewInt(3), VolumeAutoGrow: a}
+	}
+	q := func(s string) *resource.Quantity {
+		v := resource.MustParse(s)
+		return &v
+	}
+
+	// Valid
+	assert.Nil(t, spec(nil).Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Nil(t, spec(&ServerGroupVolumeAutoGrowSpec{Step: q("10Gi"), MaxSize: q("100Gi")}).Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Nil(t, spec(&ServerGroupVolumeAutoGrowSpec{Enabled: util.NewBool(false)}).Validate(ServerGroupCoordinators, true, DeploymentModeCluster, EnvironmentDevelopment))
+	// Invalid
+	assert.Error(t, spec(&ServerGroupVolumeAutoGrowSpec{Step: q("10Gi"), MaxSize: q("100Gi")}).Validate(ServerGroupCoordinators, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Error(t, spec(&ServerGroupVolumeAutoGrowSpec{MaxSize: q("100Gi")}).Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Error(t, spec(&ServerGroupVolumeAutoGrowSpec{Step: q("10Gi")}).Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Error(t, spec(&ServerGroupVolumeAutoGrowSpec{Step: q("10Gi"), MaxSize: q("100Gi"), ThresholdPercent: util.NewInt(100)}).Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+}
+
+func TestServerGroupVolumeAutoGrowSpecGetNextSize(t *testing.T) {
+	step := resource.MustParse("10Gi")
+	max := resource.MustParse("25Gi")
+	s := &ServerGroupVolumeAutoGrowSpec{Step: &step, MaxSize: &max}
+
+	next, ok := s.GetNextSize(resource.MustParse("10Gi"))
+	assert.True(t, ok)
+	assert.Equal(t, 0, next.Cmp(resource.MustParse("20Gi")))
+
+	next, ok = s.GetNextSize(resource.MustParse("20Gi"))
+	assert.True(t, ok)
+	assert.Equal(t, 0, next.Cmp(max))
+
+	_, ok = s.GetNextSize(resource.MustParse("25Gi"))
+	assert.False(t, ok)
+}
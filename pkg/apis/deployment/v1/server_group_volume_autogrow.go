@@ -0,0 +1,120 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	ServerGroupVolumeAutoGrowThresholdPercentDefault = 80
+)
+
+// ServerGroupVolumeAutoGrowSpec defines automatic growth of the member volumes based on the observed usage
+type ServerGroupVolumeAutoGrowSpec struct {
+	// Enabled turns on automatic growth of the volumes. Default to true if volumeAutoGrow section is defined
+	Enabled *bool `json:"enabled,omitempty"`
+	// ThresholdPercent defines usage of the volume (in percent) above which volume is grown. Default to 80
+	ThresholdPercent *int `json:"thresholdPercent,omitempty"`
+	// Step defines by how much volume is grown at once
+	Step *resource.Quantity `json:"step,omitempty"`
+	// MaxSize defines maximum size of the volume
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+}
+
+func (s *ServerGroupVolumeAutoGrowSpec) IsEnabled() bool {
+	if s == nil {
+		return false
+	}
+
+	if s.Enabled == nil {
+		return true
+	}
+
+	return *s.Enabled
+}
+
+func (s *ServerGroupVolumeAutoGrowSpec) GetThresholdPercent() int {
+	if s == nil || s.ThresholdPercent == nil {
+		return ServerGroupVolumeAutoGrowThresholdPercentDefault
+	}
+
+	return *s.ThresholdPercent
+}
+
+// IsAboveMaxSize returns true if size exceeds maximum size of the volume
+func (s *ServerGroupVolumeAutoGrowSpec) IsAboveMaxSize(size resource.Quantity) bool {
+	if s == nil || s.MaxSize == nil {
+		return false
+	}
+
+	return size.Cmp(*s.MaxSize) > 0
+}
+
+// GetNextSize returns size to which volume of the given size should be grown, limited by the maximum size.
+// Returns false if volume can not be grown anymore.
+func (s *ServerGroupVolumeAutoGrowSpec) GetNextSize(size resource.Quantity) (resource.Quantity, bool) {
+	if s == nil || s.Step == nil || s.MaxSize == nil {
+		return resource.Quantity{}, false
+	}
+
+	next := size.DeepCopy()
+	next.Add(*s.Step)
+
+	if next.Cmp(*s.MaxSize) > 0 {
+		next = s.MaxSize.DeepCopy()
+	}
+
+	if next.Cmp(size) <= 0 {
+		return resource.Quantity{}, false
+	}
+
+	return next, true
+}
+
+// Validate validates the volume autogrow spec of the group
+func (s *ServerGroupVolumeAutoGrowSpec) Validate(group ServerGroup) error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	switch group {
+	case ServerGroupAgents, ServerGroupDBServers, ServerGroupSingle:
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Volume autogrow is not supported for group %s", group.AsRole()))
+	}
+
+	if t := s.GetThresholdPercent(); t < 1 || t > 99 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Invalid thresholdPercent value %d. Expected value between 1 and 99", t))
+	}
+
+	if s.Step == nil || s.Step.Sign() <= 0 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Step needs to be greater than 0"))
+	}
+
+	if s.MaxSize == nil || s.MaxSize.Sign() <= 0 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "MaxSize needs to be greater than 0"))
+	}
+
+	return nil
+}
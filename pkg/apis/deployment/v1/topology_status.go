@@ -74,6 +74,22 @@ func (t *TopologyStatus) GetLeastUsedZone(group ServerGroup) int {
 	return r
 }
 
+func (t *TopologyStatus) GetMostUsedZone(group ServerGroup) int {
+	if t == nil {
+		return -1
+	}
+
+	r, m := -1, 0
+
+	for i, z := range t.Zones {
+		if v := len(z.Members[group.AsRoleAbbreviated()]); v > m {
+			r, m = i, v
+		}
+	}
+
+	return r
+}
+
 func (t *TopologyStatus) RegisterTopologyLabel(zone int, label string) bool {
 	if t == nil {
 		return false
@@ -39,3 +39,16 @@ func Test_GetLeastUsedZone(t *testing.T) {
 
 	require.Equal(t, 0, v.GetLeastUsedZone(ServerGroupDBServers))
 }
+
+func Test_GetMostUsedZone(t *testing.T) {
+	v := NewTopologyStatus(&TopologySpec{Enabled: true, Zones: 3})
+
+	require.Equal(t, -1, v.GetMostUsedZone(ServerGroupDBServers))
+
+	v.Zones[1].AddMember(ServerGroupDBServers, "M-0")
+	v.Zones[1].AddMember(ServerGroupDBServers, "M-1")
+	v.Zones[2].AddMember(ServerGroupDBServers, "M-2")
+
+	require.Equal(t, 1, v.GetMostUsedZone(ServerGroupDBServers))
+	require.Equal(t, -1, v.GetMostUsedZone(ServerGroupAgents))
+}
@@ -32,6 +32,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -327,6 +328,11 @@ func (in *ArangoDeploymentRebalancerOptimizersSpec) DeepCopyInto(out *ArangoDepl
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -471,6 +477,99 @@ func (in *ArangoMember) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoMemberGroup) DeepCopyInto(out *ArangoMemberGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberGroup.
+func (in *ArangoMemberGroup) DeepCopy() *ArangoMemberGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoMemberGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoMemberGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoMemberGroupList) DeepCopyInto(out *ArangoMemberGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArangoMemberGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberGroupList.
+func (in *ArangoMemberGroupList) DeepCopy() *ArangoMemberGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoMemberGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoMemberGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoMemberGroupSpec) DeepCopyInto(out *ArangoMemberGroupSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberGroupSpec.
+func (in *ArangoMemberGroupSpec) DeepCopy() *ArangoMemberGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoMemberGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoMemberGroupStatus) DeepCopyInto(out *ArangoMemberGroupStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoMemberGroupStatus.
+func (in *ArangoMemberGroupStatus) DeepCopy() *ArangoMemberGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoMemberGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoMemberList) DeepCopyInto(out *ArangoMemberList) {
 	*out = *in
@@ -660,6 +759,22 @@ func (in *ArangoTaskList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoTaskMemberDetails) DeepCopyInto(out *ArangoTaskMemberDetails) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoTaskMemberDetails.
+func (in *ArangoTaskMemberDetails) DeepCopy() *ArangoTaskMemberDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoTaskMemberDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoTaskSpec) DeepCopyInto(out *ArangoTaskSpec) {
 	*out = *in
@@ -953,7 +1068,7 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 	if in.Upgrade != nil {
 		in, out := &in.Upgrade, &out.Upgrade
 		*out = new(DeploymentUpgradeSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Features != nil {
 		in, out := &in.Features, &out.Features
@@ -1085,6 +1200,16 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make(MaintenanceWindows, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paused != nil {
+		in, out := &in.Paused, &out.Paused
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1121,6 +1246,11 @@ func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 		*out = new(ImageInfo)
 		**out = **in
 	}
+	if in.UpgradeCanary != nil {
+		in, out := &in.UpgradeCanary, &out.UpgradeCanary
+		*out = new(DeploymentUpgradeCanaryStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Members.DeepCopyInto(&out.Members)
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1150,6 +1280,13 @@ func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PlanHistory != nil {
+		in, out := &in.PlanHistory, &out.PlanHistory
+		*out = make(PlanHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AcceptedSpec != nil {
 		in, out := &in.AcceptedSpec, &out.AcceptedSpec
 		*out = new(DeploymentSpec)
@@ -1471,9 +1608,72 @@ func (in *DeploymentStatusMembers) DeepCopy() *DeploymentStatusMembers {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentUpgradeCanarySpec) DeepCopyInto(out *DeploymentUpgradeCanarySpec) {
+	*out = *in
+	if in.SoakPeriod != nil {
+		in, out := &in.SoakPeriod, &out.SoakPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxRestarts != nil {
+		in, out := &in.MaxRestarts, &out.MaxRestarts
+		*out = new(int)
+		**out = **in
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentUpgradeCanarySpec.
+func (in *DeploymentUpgradeCanarySpec) DeepCopy() *DeploymentUpgradeCanarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentUpgradeCanarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentUpgradeCanaryStatus) DeepCopyInto(out *DeploymentUpgradeCanaryStatus) {
+	*out = *in
+	if in.FromImage != nil {
+		in, out := &in.FromImage, &out.FromImage
+		*out = new(ImageInfo)
+		**out = **in
+	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentUpgradeCanaryStatus.
+func (in *DeploymentUpgradeCanaryStatus) DeepCopy() *DeploymentUpgradeCanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentUpgradeCanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentUpgradeSpec) DeepCopyInto(out *DeploymentUpgradeSpec) {
 	*out = *in
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(DeploymentUpgradeStrategy)
+		**out = **in
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(DeploymentUpgradeCanarySpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1674,6 +1874,59 @@ func (in List) DeepCopy() List {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in MaintenanceWindows) DeepCopyInto(out *MaintenanceWindows) {
+	{
+		in := &in
+		*out = make(MaintenanceWindows, len(*in))
+		copy(*out, *in)
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindows.
+func (in MaintenanceWindows) DeepCopy() MaintenanceWindows {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindows)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberDrainStatus) DeepCopyInto(out *MemberDrainStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberDrainStatus.
+func (in *MemberDrainStatus) DeepCopy() *MemberDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemberPodStatus) DeepCopyInto(out *MemberPodStatus) {
 	*out = *in
@@ -1708,6 +1961,11 @@ func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(MemberDrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Image != nil {
 		in, out := &in.Image, &out.Image
 		*out = new(ImageInfo)
@@ -1942,6 +2200,50 @@ func (in Plan) DeepCopy() Plan {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PlanHistory) DeepCopyInto(out *PlanHistory) {
+	{
+		in := &in
+		*out = make(PlanHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanHistory.
+func (in PlanHistory) DeepCopy() PlanHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanHistory)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlanHistoryEntry) DeepCopyInto(out *PlanHistoryEntry) {
+	*out = *in
+	in.CreationTime.DeepCopyInto(&out.CreationTime)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanHistoryEntry.
+func (in *PlanHistoryEntry) DeepCopy() *PlanHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in PlanLocals) DeepCopyInto(out *PlanLocals) {
 	{
@@ -2025,6 +2327,78 @@ func (in *SecretHashes) DeepCopy() *SecretHashes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerGroupAutoscalingBehaviour) DeepCopyInto(out *ServerGroupAutoscalingBehaviour) {
+	*out = *in
+	if in.StabilizationWindowSeconds != nil {
+		in, out := &in.StabilizationWindowSeconds, &out.StabilizationWindowSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.CooldownSeconds != nil {
+		in, out := &in.CooldownSeconds, &out.CooldownSeconds
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupAutoscalingBehaviour.
+func (in *ServerGroupAutoscalingBehaviour) DeepCopy() *ServerGroupAutoscalingBehaviour {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerGroupAutoscalingBehaviour)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerGroupAutoscalingSpec) DeepCopyInto(out *ServerGroupAutoscalingSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TargetRequestsInProgress != nil {
+		in, out := &in.TargetRequestsInProgress, &out.TargetRequestsInProgress
+		*out = new(int)
+		**out = **in
+	}
+	if in.TargetSchedulerQueueLength != nil {
+		in, out := &in.TargetSchedulerQueueLength, &out.TargetSchedulerQueueLength
+		*out = new(int)
+		**out = **in
+	}
+	if in.TargetClientConnections != nil {
+		in, out := &in.TargetClientConnections, &out.TargetClientConnections
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScaleUp != nil {
+		in, out := &in.ScaleUp, &out.ScaleUp
+		*out = new(ServerGroupAutoscalingBehaviour)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleDown != nil {
+		in, out := &in.ScaleDown, &out.ScaleDown
+		*out = new(ServerGroupAutoscalingBehaviour)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupAutoscalingSpec.
+func (in *ServerGroupAutoscalingSpec) DeepCopy() *ServerGroupAutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerGroupAutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerGroupEnvVar) DeepCopyInto(out *ServerGroupEnvVar) {
 	*out = *in
@@ -2303,6 +2677,11 @@ func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
 		*out = new(PVCResizeMode)
 		**out = **in
 	}
+	if in.VolumeAutoGrow != nil {
+		in, out := &in.VolumeAutoGrow, &out.VolumeAutoGrow
+		*out = new(ServerGroupVolumeAutoGrowSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VolumeAllowShrink != nil {
 		in, out := &in.VolumeAllowShrink, &out.VolumeAllowShrink
 		*out = new(bool)
@@ -2323,6 +2702,13 @@ func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
 		*out = new(corev1.NodeAffinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SidecarCoreNames != nil {
 		in, out := &in.SidecarCoreNames, &out.SidecarCoreNames
 		*out = make([]string, len(*in))
@@ -2409,6 +2795,16 @@ func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
 		*out = new(ServerGroupIndexMethod)
 		**out = **in
 	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(ServerGroupAutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2719,6 +3115,42 @@ func (in ServerGroupSpecVolumes) DeepCopy() ServerGroupSpecVolumes {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerGroupVolumeAutoGrowSpec) DeepCopyInto(out *ServerGroupVolumeAutoGrowSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ThresholdPercent != nil {
+		in, out := &in.ThresholdPercent, &out.ThresholdPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupVolumeAutoGrowSpec.
+func (in *ServerGroupVolumeAutoGrowSpec) DeepCopy() *ServerGroupVolumeAutoGrowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerGroupVolumeAutoGrowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerGroupStatus) DeepCopyInto(out *ServerGroupStatus) {
 	*out = *in
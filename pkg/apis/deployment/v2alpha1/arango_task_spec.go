@@ -20,10 +20,58 @@
 
 package v2alpha1
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
 
 type ArangoTaskType string
 
+const (
+	// ArangoTaskRestartMemberType restarts the member
+	ArangoTaskRestartMemberType ArangoTaskType = "RestartMember"
+	// ArangoTaskResignLeadershipType moves leadership of the shards away from the DBServer
+	ArangoTaskResignLeadershipType ArangoTaskType = "ResignLeadership"
+	// ArangoTaskCleanOutMemberType moves all shards away from the DBServer
+	ArangoTaskCleanOutMemberType ArangoTaskType = "CleanOutMember"
+	// ArangoTaskReplaceMemberType replaces the member with the new one
+	ArangoTaskReplaceMemberType ArangoTaskType = "ReplaceMember"
+	// ArangoTaskRotateJWTType generates new JWT token and rotates it
+	ArangoTaskRotateJWTType ArangoTaskType = "RotateJWT"
+	// ArangoTaskRotateTLSCAType renews the CA certificate
+	ArangoTaskRotateTLSCAType ArangoTaskType = "RotateTLSCA"
+	// ArangoTaskEnableMaintenanceType enables the maintenance mode of the cluster
+	ArangoTaskEnableMaintenanceType ArangoTaskType = "EnableMaintenance"
+)
+
+// Validate checks if task type is supported
+func (a ArangoTaskType) Validate() error {
+	switch a {
+	case ArangoTaskRestartMemberType, ArangoTaskResignLeadershipType, ArangoTaskCleanOutMemberType, ArangoTaskReplaceMemberType,
+		ArangoTaskRotateJWTType, ArangoTaskRotateTLSCAType, ArangoTaskEnableMaintenanceType:
+		return nil
+	default:
+		return errors.Newf("Unsupported task type: %s", a)
+	}
+}
+
+// IsMemberTask returns true if task is executed on the member defined in details
+func (a ArangoTaskType) IsMemberTask() bool {
+	switch a {
+	case ArangoTaskRestartMemberType, ArangoTaskResignLeadershipType, ArangoTaskCleanOutMemberType, ArangoTaskReplaceMemberType:
+		return true
+	default:
+		return false
+	}
+}
+
+// ArangoTaskMemberDetails define details of the task executed on the member
+type ArangoTaskMemberDetails struct {
+	// ID of the member
+	ID string `json:"id"`
+}
+
 type ArangoTaskDetails []byte
 
 func (a ArangoTaskDetails) MarshalJSON() ([]byte, error) {
@@ -67,8 +115,31 @@ func (a *ArangoTaskDetails) Set(i interface{}) error {
 var _ json.Unmarshaler = &ArangoTaskDetails{}
 var _ json.Marshaler = ArangoTaskDetails{}
 
+// ArangoTaskSpec define the task to be executed on the deployment which owns the ArangoTask
 type ArangoTaskSpec struct {
 	Type ArangoTaskType `json:"type,omitempty"`
 
 	Details ArangoTaskDetails `json:"details,omitempty"`
 }
+
+// GetMemberID returns ID of the member from details of the member task
+func (a ArangoTaskSpec) GetMemberID() (string, error) {
+	if !a.Type.IsMemberTask() {
+		return "", errors.Newf("Task %s is not executed on the member", a.Type)
+	}
+
+	if len(a.Details) == 0 {
+		return "", errors.Newf("Member ID is missing in task details")
+	}
+
+	var d ArangoTaskMemberDetails
+	if err := json.Unmarshal(a.Details, &d); err != nil {
+		return "", errors.Wrapf(err, "Unable to parse task details")
+	}
+
+	if d.ID == "" {
+		return "", errors.Newf("Member ID is missing in task details")
+	}
+
+	return d.ID, nil
+}
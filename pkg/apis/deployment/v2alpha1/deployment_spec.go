@@ -163,7 +163,7 @@ type DeploymentSpec struct {
 	// CommunicationMethod define communication method used in deployment
 	CommunicationMethod *DeploymentCommunicationMethod `json:"communicationMethod,omitempty"`
 
-	// Topology define topology adjustment details, Enterprise only
+	// Topology define topology adjustment details
 	Topology *TopologySpec `json:"topology,omitempty"`
 
 	// Rebalancer define the rebalancer specification
@@ -173,6 +173,14 @@ type DeploymentSpec struct {
 	Architecture ArangoDeploymentArchitecture `json:"architecture,omitempty"`
 
 	Timezone *string `json:"timezone,omitempty"`
+
+	// MaintenanceWindows define time windows in which actions restarting or shutting down members are allowed.
+	// If not set, such actions are allowed at any time.
+	MaintenanceWindows MaintenanceWindows `json:"maintenanceWindows,omitempty"`
+
+	// Paused stops creation and execution of new plan actions. Actions which are already started are finished.
+	// Status of the deployment is still updated while reconciliation is paused.
+	Paused *bool `json:"paused,omitempty"`
 }
 
 // GetAllowMemberRecreation returns member recreation policy based on group and settings
@@ -253,6 +261,11 @@ func (s DeploymentSpec) IsDowntimeAllowed() bool {
 	return util.BoolOrDefault(s.DowntimeAllowed)
 }
 
+// IsPaused returns the value of paused, default false
+func (s DeploymentSpec) IsPaused() bool {
+	return util.BoolOrDefault(s.Paused, false)
+}
+
 // IsDisableIPv6 returns the value of disableIPv6.
 func (s DeploymentSpec) IsDisableIPv6() bool {
 	return util.BoolOrDefault(s.DisableIPv6)
@@ -469,6 +482,12 @@ func (s *DeploymentSpec) Validate() error {
 	if err := s.Architecture.Validate(); err != nil {
 		return errors.WithStack(errors.Wrap(err, "spec.architecture"))
 	}
+	if err := s.MaintenanceWindows.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.maintenanceWindows"))
+	}
+	if err := s.Upgrade.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.upgrade"))
+	}
 	return nil
 }
 
@@ -0,0 +1,117 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"time"
+
+	"github.com/robfig/cron"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// MaintenanceWindow defines time window in which actions restarting or shutting down members are allowed
+type MaintenanceWindow struct {
+	// Schedule defines start of the window in cron format. Schedule is evaluated in the deployment timezone.
+	Schedule string `json:"schedule"`
+
+	// Duration of the window
+	Duration meta.Duration `json:"duration"`
+}
+
+// Validate the MaintenanceWindow
+func (m MaintenanceWindow) Validate() error {
+	if expr, err := cron.ParseStandard(m.Schedule); err != nil {
+		return errors.Newf("error while parsing schedule: %s", err.Error())
+	} else if expr.Next(time.Now()).IsZero() {
+		return errors.Newf("invalid schedule format")
+	}
+
+	if m.Duration.Duration <= 0 {
+		return errors.Newf("duration needs to be greater than 0")
+	}
+
+	return nil
+}
+
+// activeSince returns start of the window which includes given time, if any
+func (m MaintenanceWindow) activeSince(now time.Time) (time.Time, bool) {
+	expr, err := cron.ParseStandard(m.Schedule)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	start := expr.Next(now.Add(-m.Duration.Duration))
+	if start.IsZero() || start.After(now) {
+		return time.Time{}, false
+	}
+
+	return start, true
+}
+
+// MaintenanceWindows defines list of maintenance windows
+type MaintenanceWindows []MaintenanceWindow
+
+// Validate the MaintenanceWindows
+func (m MaintenanceWindows) Validate() error {
+	for id, w := range m {
+		if err := w.Validate(); err != nil {
+			return errors.Wrapf(err, "%d", id)
+		}
+	}
+
+	return nil
+}
+
+// IsOpen returns true when any of the windows includes given time. If no window is defined, it is always open.
+// Time needs to be provided in the deployment timezone.
+func (m MaintenanceWindows) IsOpen(now time.Time) bool {
+	if len(m) == 0 {
+		return true
+	}
+
+	for _, w := range m {
+		if _, ok := w.activeSince(now); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Next returns start of the next window after given time
+func (m MaintenanceWindows) Next(now time.Time) (time.Time, bool) {
+	var next time.Time
+
+	for _, w := range m {
+		expr, err := cron.ParseStandard(w.Schedule)
+		if err != nil {
+			continue
+		}
+
+		if n := expr.Next(now); !n.IsZero() && (next.IsZero() || n.Before(next)) {
+			next = n
+		}
+	}
+
+	return next, !next.IsZero()
+}
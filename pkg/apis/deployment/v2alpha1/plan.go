@@ -110,6 +110,8 @@ const (
 	ActionTypeSetCurrentImage ActionType = "SetCurrentImage"
 	// ActionTypeSetMemberCurrentImage replace image of member to current one.
 	ActionTypeSetMemberCurrentImage ActionType = "SetMemberCurrentImage"
+	// ActionTypeUpgradeCanaryUpdate updates phase of the canary upgrade in status.
+	ActionTypeUpgradeCanaryUpdate ActionType = "UpgradeCanaryUpdate"
 	// ActionTypeDisableClusterScaling turns off scaling DBservers and coordinators
 	ActionTypeDisableClusterScaling ActionType = "ScalingDisabled"
 	// ActionTypeEnableClusterScaling turns on scaling DBservers and coordinators
@@ -124,6 +126,8 @@ const (
 	ActionTypeBackupRestore ActionType = "BackupRestore"
 	// ActionTypeBackupRestoreClean restore plan
 	ActionTypeBackupRestoreClean ActionType = "BackupRestoreClean"
+	// ActionTypeArangoRestore restores backup referenced by the ArangoRestore resource
+	ActionTypeArangoRestore ActionType = "ArangoRestore"
 	// ActionTypeEncryptionKeyAdd add new encryption key to list
 	ActionTypeEncryptionKeyAdd ActionType = "EncryptionKeyAdd"
 	// ActionTypeEncryptionKeyRemove removes encryption key to list
@@ -146,6 +150,8 @@ const (
 	ActionTypeJWTRefresh ActionType = "JWTRefresh"
 	// ActionTypeJWTPropagated change propagated flag
 	ActionTypeJWTPropagated ActionType = "JWTPropagated"
+	// ActionTypeJWTRenew generates new token in the JWT secret.
+	ActionTypeJWTRenew ActionType = "JWTRenew"
 	// ActionTypeClusterMemberCleanup removes member from cluster
 	ActionTypeClusterMemberCleanup ActionType = "ClusterMemberCleanup"
 	// ActionTypeEnableMaintenance enables maintenance on cluster.
@@ -180,6 +186,8 @@ const (
 	ActionTypeArangoMemberUpdatePodStatus ActionType = "ArangoMemberUpdatePodStatus"
 	// ActionTypeLicenseSet sets server license
 	ActionTypeLicenseSet ActionType = "LicenseSet"
+	// ActionTypeArangoTaskStatusUpdate updates state of the ArangoTask
+	ActionTypeArangoTaskStatusUpdate ActionType = "ArangoTaskStatusUpdate"
 
 	// Runtime Updates
 	// ActionTypeRuntimeContainerImageUpdate updates container image in runtime
@@ -0,0 +1,159 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+// PlanHistoryLimit defines the maximum number of entries kept in the plan history
+const PlanHistoryLimit = 64
+
+type PlanHistoryResult string
+
+const (
+	// PlanHistoryResultSucceeded is set when action finished successfully
+	PlanHistoryResultSucceeded PlanHistoryResult = "Succeeded"
+	// PlanHistoryResultFailed is set when action returned an error and plan was removed
+	PlanHistoryResultFailed PlanHistoryResult = "Failed"
+	// PlanHistoryResultAborted is set when action aborted the plan
+	PlanHistoryResultAborted PlanHistoryResult = "Aborted"
+	// PlanHistoryResultTimeout is set when action did not finish in time and plan was removed
+	PlanHistoryResultTimeout PlanHistoryResult = "Timeout"
+	// PlanHistoryResultCancelled is set when action was removed from the plan by the cancel annotation
+	PlanHistoryResultCancelled PlanHistoryResult = "Cancelled"
+)
+
+// PlanHistoryEntry keeps details of the executed action
+type PlanHistoryEntry struct {
+	// ID of the executed action
+	ID string `json:"id"`
+	// Type of the executed action
+	Type ActionType `json:"type"`
+	// Priority of the plan in which action was executed
+	Priority string `json:"priority,omitempty"`
+	// MemberID of the member involved in the action (if any)
+	MemberID string `json:"memberID,omitempty"`
+	// Group involved in the action
+	Group ServerGroup `json:"group,omitempty"`
+	// Reason for which action was planned
+	Reason string `json:"reason,omitempty"`
+	// CreationTime is the time when action was added to the plan
+	CreationTime meta.Time `json:"creationTime"`
+	// StartTime is the time when action was started
+	StartTime *meta.Time `json:"startTime,omitempty"`
+	// EndTime is the time when action was finished
+	EndTime meta.Time `json:"endTime"`
+	// Result of the action
+	Result PlanHistoryResult `json:"result"`
+	// Error returned by the action
+	Error string `json:"error,omitempty"`
+}
+
+// NewPlanHistoryEntry creates PlanHistoryEntry for the finished action
+func NewPlanHistoryEntry(action Action, priority string, result PlanHistoryResult, err error) PlanHistoryEntry {
+	e := PlanHistoryEntry{
+		ID:           action.ID,
+		Type:         action.Type,
+		Priority:     priority,
+		MemberID:     action.MemberID,
+		Group:        action.Group,
+		Reason:       action.Reason,
+		CreationTime: action.CreationTime,
+		EndTime:      meta.Now(),
+		Result:       result,
+	}
+
+	if t := action.StartTime; t != nil {
+		e.StartTime = t.DeepCopy()
+	}
+
+	if err != nil {
+		e.Error = err.Error()
+	}
+
+	return e
+}
+
+// Equal compares two PlanHistoryEntry
+func (p PlanHistoryEntry) Equal(other PlanHistoryEntry) bool {
+	return p.ID == other.ID &&
+		p.Type == other.Type &&
+		p.Priority == other.Priority &&
+		p.MemberID == other.MemberID &&
+		p.Group == other.Group &&
+		p.Reason == other.Reason &&
+		util.TimeCompareEqual(p.CreationTime, other.CreationTime) &&
+		p.StartTime.Equal(other.StartTime) &&
+		util.TimeCompareEqual(p.EndTime, other.EndTime) &&
+		p.Result == other.Result &&
+		p.Error == other.Error
+}
+
+// PlanHistory keeps the latest executed actions, oldest first
+type PlanHistory []PlanHistoryEntry
+
+// Equal compares two PlanHistory
+func (p PlanHistory) Equal(other PlanHistory) bool {
+	if len(p) != len(other) {
+		return false
+	}
+
+	for i := range p {
+		if !p[i].Equal(other[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Append returns the history with entries added at the end. Only the latest PlanHistoryLimit entries are kept.
+func (p PlanHistory) Append(entries ...PlanHistoryEntry) PlanHistory {
+	if len(entries) == 0 {
+		return p
+	}
+
+	r := make(PlanHistory, 0, len(p)+len(entries))
+	r = append(r, p...)
+	r = append(r, entries...)
+
+	if len(r) > PlanHistoryLimit {
+		r = r[len(r)-PlanHistoryLimit:]
+	}
+
+	return r
+}
+
+// FilterByMember returns entries of the member with given ID
+func (p PlanHistory) FilterByMember(id string) PlanHistory {
+	var r PlanHistory
+
+	for _, e := range p {
+		if e.MemberID == id {
+			r = append(r, e)
+		}
+	}
+
+	return r
+}
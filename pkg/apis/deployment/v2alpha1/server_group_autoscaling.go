@@ -0,0 +1,177 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// ServerGroupAutoscalingSpec defines the metric-driven autoscaling of the group.
+// Autoscaling is supported only for Coordinators.
+type ServerGroupAutoscalingSpec struct {
+	// Enabled turns on autoscaling of the group. Default to true if autoscaling section is defined
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// TargetRequestsInProgress defines expected average number of requests in progress per server
+	TargetRequestsInProgress *int `json:"targetRequestsInProgress,omitempty"`
+	// TargetSchedulerQueueLength defines expected average length of the scheduler queue per server
+	TargetSchedulerQueueLength *int `json:"targetSchedulerQueueLength,omitempty"`
+	// TargetClientConnections defines expected average number of client connections per server
+	TargetClientConnections *int `json:"targetClientConnections,omitempty"`
+
+	// ScaleUp defines behaviour of the scale up
+	ScaleUp *ServerGroupAutoscalingBehaviour `json:"scaleUp,omitempty"`
+	// ScaleDown defines behaviour of the scale down
+	ScaleDown *ServerGroupAutoscalingBehaviour `json:"scaleDown,omitempty"`
+}
+
+// ServerGroupAutoscalingBehaviour defines how fast the group can be scaled in one direction
+type ServerGroupAutoscalingBehaviour struct {
+	// StabilizationWindowSeconds defines for how long past recommendations are taken into account while scaling
+	StabilizationWindowSeconds *int `json:"stabilizationWindowSeconds,omitempty"`
+	// CooldownSeconds defines minimal time between two scaling operations
+	CooldownSeconds *int `json:"cooldownSeconds,omitempty"`
+}
+
+const (
+	ServerGroupAutoscalingScaleUpStabilizationWindowDefault   = 0
+	ServerGroupAutoscalingScaleUpCooldownDefault              = 60
+	ServerGroupAutoscalingScaleDownStabilizationWindowDefault = 300
+	ServerGroupAutoscalingScaleDownCooldownDefault            = 300
+)
+
+func (s *ServerGroupAutoscalingSpec) IsEnabled() bool {
+	if s == nil {
+		return false
+	}
+
+	if s.Enabled == nil {
+		return true
+	}
+
+	return *s.Enabled
+}
+
+// HasTargets returns true if at least one target is defined
+func (s *ServerGroupAutoscalingSpec) HasTargets() bool {
+	if s == nil {
+		return false
+	}
+
+	return s.TargetRequestsInProgress != nil || s.TargetSchedulerQueueLength != nil || s.TargetClientConnections != nil
+}
+
+func (s *ServerGroupAutoscalingSpec) GetScaleUpStabilizationWindow() time.Duration {
+	return s.getScaleUp().getStabilizationWindow(ServerGroupAutoscalingScaleUpStabilizationWindowDefault)
+}
+
+func (s *ServerGroupAutoscalingSpec) GetScaleUpCooldown() time.Duration {
+	return s.getScaleUp().getCooldown(ServerGroupAutoscalingScaleUpCooldownDefault)
+}
+
+func (s *ServerGroupAutoscalingSpec) GetScaleDownStabilizationWindow() time.Duration {
+	return s.getScaleDown().getStabilizationWindow(ServerGroupAutoscalingScaleDownStabilizationWindowDefault)
+}
+
+func (s *ServerGroupAutoscalingSpec) GetScaleDownCooldown() time.Duration {
+	return s.getScaleDown().getCooldown(ServerGroupAutoscalingScaleDownCooldownDefault)
+}
+
+func (s *ServerGroupAutoscalingSpec) getScaleUp() *ServerGroupAutoscalingBehaviour {
+	if s == nil {
+		return nil
+	}
+
+	return s.ScaleUp
+}
+
+func (s *ServerGroupAutoscalingSpec) getScaleDown() *ServerGroupAutoscalingBehaviour {
+	if s == nil {
+		return nil
+	}
+
+	return s.ScaleDown
+}
+
+// Validate validates the autoscaling spec of the group
+func (s *ServerGroupAutoscalingSpec) Validate(group ServerGroup) error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	if group != ServerGroupCoordinators {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Autoscaling is not supported for group %s", group.AsRole()))
+	}
+
+	if !s.HasTargets() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "At least one target needs to be defined"))
+	}
+
+	for name, v := range map[string]*int{
+		"targetRequestsInProgress":   s.TargetRequestsInProgress,
+		"targetSchedulerQueueLength": s.TargetSchedulerQueueLength,
+		"targetClientConnections":    s.TargetClientConnections,
+	} {
+		if v != nil && *v < 1 {
+			return errors.WithStack(errors.Wrapf(ValidationError, "Invalid %s value %d. Expected >= 1", name, *v))
+		}
+	}
+
+	return shared.WithErrors(
+		shared.PrefixResourceError("scaleUp", s.ScaleUp.Validate()),
+		shared.PrefixResourceError("scaleDown", s.ScaleDown.Validate()),
+	)
+}
+
+func (s *ServerGroupAutoscalingBehaviour) getStabilizationWindow(d int) time.Duration {
+	if s == nil || s.StabilizationWindowSeconds == nil {
+		return time.Duration(d) * time.Second
+	}
+
+	return time.Duration(*s.StabilizationWindowSeconds) * time.Second
+}
+
+func (s *ServerGroupAutoscalingBehaviour) getCooldown(d int) time.Duration {
+	if s == nil || s.CooldownSeconds == nil {
+		return time.Duration(d) * time.Second
+	}
+
+	return time.Duration(*s.CooldownSeconds) * time.Second
+}
+
+func (s *ServerGroupAutoscalingBehaviour) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	if v := s.StabilizationWindowSeconds; v != nil && *v < 0 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Invalid stabilizationWindowSeconds value %d. Expected >= 0", *v))
+	}
+
+	if v := s.CooldownSeconds; v != nil && *v < 0 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Invalid cooldownSeconds value %d. Expected >= 0", *v))
+	}
+
+	return nil
+}
@@ -27,6 +27,7 @@ import (
 
 	core "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/arangodb/kube-arangodb/pkg/apis/shared"
 	"github.com/arangodb/kube-arangodb/pkg/util"
@@ -114,6 +115,8 @@ type ServerGroupSpec struct {
 	VolumeClaimTemplate *core.PersistentVolumeClaim `json:"volumeClaimTemplate,omitempty"`
 	// VolumeResizeMode specified resize mode for pvc
 	VolumeResizeMode *PVCResizeMode `json:"pvcResizeMode,omitempty"`
+	// VolumeAutoGrow defines automatic growth of the volumes based on the observed usage
+	VolumeAutoGrow *ServerGroupVolumeAutoGrowSpec `json:"volumeAutoGrow,omitempty"`
 	// Deprecated: VolumeAllowShrink allows shrink the volume
 	VolumeAllowShrink *bool `json:"volumeAllowShrink,omitempty"`
 	// AntiAffinity specified additional antiAffinity settings in ArangoDB Pod definitions
@@ -122,6 +125,9 @@ type ServerGroupSpec struct {
 	Affinity *core.PodAffinity `json:"affinity,omitempty"`
 	// NodeAffinity specified additional nodeAffinity settings in ArangoDB Pod definitions
 	NodeAffinity *core.NodeAffinity `json:"nodeAffinity,omitempty"`
+	// TopologySpreadConstraints specifies how ArangoDB Pods of the group are spread across topology domains.
+	// If LabelSelector of the constraint is not set, it is generated to match Pods of the group.
+	TopologySpreadConstraints []core.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 	// SidecarCoreNames is a list of sidecar containers which must run in the pod.
 	// Some names (e.g.: "server", "worker") are reserved, and they don't have any impact.
 	SidecarCoreNames []string `json:"sidecarCoreNames,omitempty"`
@@ -155,6 +161,11 @@ type ServerGroupSpec struct {
 	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
 	// IndexMethod define group Indexing method
 	IndexMethod *ServerGroupIndexMethod `json:"indexMethod,omitempty"`
+	// MaxUnavailable define how many members of the group (count or percent of the count) can be rotated at the same time.
+	// Value is ignored for Agents and Single servers, which are always rotated one by one.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// Autoscaling defines metric-driven autoscaling of the group. Supported only for Coordinators.
+	Autoscaling *ServerGroupAutoscalingSpec `json:"autoscaling,omitempty"`
 }
 
 // ServerGroupSpecSecurityContext contains specification for pod security context
@@ -407,6 +418,21 @@ func (s ServerGroupSpec) GetStorageClassName() string {
 	return util.StringOrDefault(s.StorageClassName)
 }
 
+// GetVolumeAutoGrow returns the volume autogrow spec of the group
+func (s ServerGroupSpec) GetVolumeAutoGrow() *ServerGroupVolumeAutoGrowSpec {
+	return s.VolumeAutoGrow
+}
+
+// GetAutoscaling returns the autoscaling spec of the group
+func (s ServerGroupSpec) GetAutoscaling() *ServerGroupAutoscalingSpec {
+	return s.Autoscaling
+}
+
+// GetTopologySpreadConstraints returns the value of topologySpreadConstraints.
+func (s ServerGroupSpec) GetTopologySpreadConstraints() []core.TopologySpreadConstraint {
+	return s.TopologySpreadConstraints
+}
+
 // GetTolerations returns the value of tolerations.
 func (s ServerGroupSpec) GetTolerations() []core.Toleration {
 	return s.Tolerations
@@ -517,6 +543,14 @@ func (s ServerGroupSpec) Validate(group ServerGroup, used bool, mode DeploymentM
 		if err := s.validate(); err != nil {
 			return errors.WithStack(err)
 		}
+
+		if err := shared.PrefixResourceError("autoscaling", s.Autoscaling.Validate(group)); err != nil {
+			return errors.WithStack(err)
+		}
+
+		if err := shared.PrefixResourceError("volumeAutoGrow", s.VolumeAutoGrow.Validate(group)); err != nil {
+			return errors.WithStack(err)
+		}
 	} else if s.GetCount() != 0 {
 		return errors.WithStack(errors.Wrapf(ValidationError, "Invalid count value %d for un-used group. Expected 0", s.GetCount()))
 	}
@@ -542,10 +576,49 @@ func (s *ServerGroupSpec) validate() error {
 		shared.PrefixResourceError("volumeMounts", s.VolumeMounts.Validate()),
 		shared.PrefixResourceError("initContainers", s.InitContainers.Validate()),
 		shared.PrefixResourceError("IndexMethod", s.IndexMethod.Validate()),
+		shared.PrefixResourceError("maxUnavailable", s.validateMaxUnavailable()),
+		shared.PrefixResourceError("topologySpreadConstraints", s.validateTopologySpreadConstraints()),
 		s.validateVolumes(),
 	)
 }
 
+func (s *ServerGroupSpec) validateMaxUnavailable() error {
+	if s.MaxUnavailable == nil {
+		return nil
+	}
+
+	v, err := intstr.GetScaledValueFromIntOrPercent(s.MaxUnavailable, s.GetCount(), false)
+	if err != nil {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Invalid value %s: %s", s.MaxUnavailable.String(), err))
+	}
+
+	if v < 0 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Invalid value %s. Expected >= 0", s.MaxUnavailable.String()))
+	}
+
+	return nil
+}
+
+func (s *ServerGroupSpec) validateTopologySpreadConstraints() error {
+	for id, c := range s.TopologySpreadConstraints {
+		if c.MaxSkew < 1 {
+			return errors.WithStack(errors.Wrapf(ValidationError, "Invalid maxSkew %d of constraint %d. Expected >= 1", c.MaxSkew, id))
+		}
+
+		if c.TopologyKey == "" {
+			return errors.WithStack(errors.Wrapf(ValidationError, "Missing topologyKey of constraint %d", id))
+		}
+
+		switch c.WhenUnsatisfiable {
+		case core.DoNotSchedule, core.ScheduleAnyway:
+		default:
+			return errors.WithStack(errors.Wrapf(ValidationError, "Invalid whenUnsatisfiable %s of constraint %d", c.WhenUnsatisfiable, id))
+		}
+	}
+
+	return nil
+}
+
 func (s *ServerGroupSpec) validateVolumes() error {
 	volumes := map[string]bool{}
 
@@ -724,6 +797,25 @@ func (s ServerGroupSpec) GetTerminationGracePeriod(group ServerGroup) time.Durat
 	}
 }
 
+// GetMaxUnavailable returns number of members which can be rotated at the same time. Defaults to 1
+func (s ServerGroupSpec) GetMaxUnavailable(group ServerGroup) int {
+	switch group {
+	case ServerGroupAgents, ServerGroupSingle:
+		return 1
+	}
+
+	if s.MaxUnavailable == nil {
+		return 1
+	}
+
+	v, err := intstr.GetScaledValueFromIntOrPercent(s.MaxUnavailable, s.GetCount(), false)
+	if err != nil || v < 1 {
+		return 1
+	}
+
+	return v
+}
+
 // GetExternalPortEnabled returns value of ExternalPortEnabled. If ExternalPortEnabled is nil true is returned
 func (s ServerGroupSpec) GetExternalPortEnabled() bool {
 	if v := s.ExternalPortEnabled; v == nil {
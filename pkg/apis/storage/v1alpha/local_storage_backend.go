@@ -0,0 +1,148 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1alpha
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// LocalStorageBackendType defines how the volumes are provisioned on the nodes
+type LocalStorageBackendType string
+
+const (
+	// LocalStorageBackendDirectory provisions volumes as directories in the local paths
+	LocalStorageBackendDirectory LocalStorageBackendType = "Directory"
+	// LocalStorageBackendLVM provisions volumes as LVM thin volumes
+	LocalStorageBackendLVM LocalStorageBackendType = "LVM"
+	// LocalStorageBackendLoopFile provisions volumes as sparse files in the local paths attached to loop devices
+	LocalStorageBackendLoopFile LocalStorageBackendType = "LoopFile"
+)
+
+// Get returns the backend type, Directory by default
+func (l *LocalStorageBackendType) Get() LocalStorageBackendType {
+	if l == nil || *l == "" {
+		return LocalStorageBackendDirectory
+	}
+
+	return *l
+}
+
+// IsBlock returns true if backend provisions block devices
+func (l *LocalStorageBackendType) IsBlock() bool {
+	switch l.Get() {
+	case LocalStorageBackendLVM, LocalStorageBackendLoopFile:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate the backend type
+func (l *LocalStorageBackendType) Validate() error {
+	switch v := l.Get(); v {
+	case LocalStorageBackendDirectory, LocalStorageBackendLVM, LocalStorageBackendLoopFile:
+		return nil
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Unknown backend type %s", v))
+	}
+}
+
+const (
+	// DefaultLocalStorageFSType is the filesystem created on the block volumes
+	DefaultLocalStorageFSType = "ext4"
+)
+
+// LocalStorageBackendSpec defines the backend used by the provisioners
+type LocalStorageBackendSpec struct {
+	// Type of the backend. Default to Directory
+	Type *LocalStorageBackendType `json:"type,omitempty"`
+
+	// FSType defines filesystem created on the block volumes. Default to ext4
+	FSType *string `json:"fsType,omitempty"`
+
+	// LVM defines settings of the LVM backend
+	LVM *LocalStorageLVMSpec `json:"lvm,omitempty"`
+}
+
+// GetType returns the backend type
+func (l *LocalStorageBackendSpec) GetType() LocalStorageBackendType {
+	if l == nil {
+		return LocalStorageBackendDirectory
+	}
+
+	return l.Type.Get()
+}
+
+// GetFSType returns the filesystem created on the block volumes
+func (l *LocalStorageBackendSpec) GetFSType() string {
+	if l == nil || l.FSType == nil || *l.FSType == "" {
+		return DefaultLocalStorageFSType
+	}
+
+	return *l.FSType
+}
+
+// GetLVM returns settings of the LVM backend
+func (l *LocalStorageBackendSpec) GetLVM() LocalStorageLVMSpec {
+	if l == nil || l.LVM == nil {
+		return LocalStorageLVMSpec{}
+	}
+
+	return *l.LVM
+}
+
+// Validate the backend spec
+func (l *LocalStorageBackendSpec) Validate() error {
+	if l == nil {
+		return nil
+	}
+
+	if err := l.Type.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if l.GetType() == LocalStorageBackendLVM {
+		if err := l.GetLVM().Validate(); err != nil {
+			return errors.WithStack(errors.Wrapf(err, "lvm"))
+		}
+	}
+
+	return nil
+}
+
+// LocalStorageLVMSpec defines settings of the LVM backend
+type LocalStorageLVMSpec struct {
+	// VolumeGroup is the name of the volume group containing the thin pool
+	VolumeGroup string `json:"volumeGroup,omitempty"`
+	// ThinPool is the name of the thin pool in which volumes are created
+	ThinPool string `json:"thinPool,omitempty"`
+}
+
+// Validate the LVM settings
+func (l LocalStorageLVMSpec) Validate() error {
+	if l.VolumeGroup == "" {
+		return errors.WithStack(errors.Wrapf(ValidationError, "volumeGroup cannot be empty"))
+	}
+	if l.ThinPool == "" {
+		return errors.WithStack(errors.Wrapf(ValidationError, "thinPool cannot be empty"))
+	}
+	return nil
+}
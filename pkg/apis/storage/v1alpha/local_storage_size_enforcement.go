@@ -0,0 +1,59 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1alpha
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// LocalStorageSizeEnforcement defines how size of the volumes is enforced
+type LocalStorageSizeEnforcement string
+
+const (
+	// LocalStorageSizeEnforcementNone does not limit size of the volumes
+	LocalStorageSizeEnforcementNone LocalStorageSizeEnforcement = "None"
+	// LocalStorageSizeEnforcementProjectQuota limits size of the volumes with filesystem project quotas
+	LocalStorageSizeEnforcementProjectQuota LocalStorageSizeEnforcement = "ProjectQuota"
+)
+
+// Get returns the size enforcement mode, None by default
+func (l *LocalStorageSizeEnforcement) Get() LocalStorageSizeEnforcement {
+	if l == nil || *l == "" {
+		return LocalStorageSizeEnforcementNone
+	}
+
+	return *l
+}
+
+// IsEnforced returns true if size of the volumes needs to be limited
+func (l *LocalStorageSizeEnforcement) IsEnforced() bool {
+	return l.Get() != LocalStorageSizeEnforcementNone
+}
+
+// Validate the size enforcement mode
+func (l *LocalStorageSizeEnforcement) Validate() error {
+	switch v := l.Get(); v {
+	case LocalStorageSizeEnforcementNone, LocalStorageSizeEnforcementProjectQuota:
+		return nil
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Unknown sizeEnforcement value %s", v))
+	}
+}
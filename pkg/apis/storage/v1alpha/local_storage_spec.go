@@ -21,6 +21,7 @@
 package v1alpha
 
 import (
+	"reflect"
 	"strings"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
@@ -36,6 +37,12 @@ type LocalStorageSpec struct {
 	Privileged   *bool             `json:"privileged,omitempty"`
 
 	PodCustomization *LocalStoragePodCustomization `json:"podCustomization,omitempty"`
+
+	// SizeEnforcement defines how size of the volumes is enforced. Default to None
+	SizeEnforcement *LocalStorageSizeEnforcement `json:"sizeEnforcement,omitempty"`
+
+	// Backend defines how the volumes are provisioned on the nodes. Default to Directory
+	Backend *LocalStorageBackendSpec `json:"backend,omitempty"`
 }
 
 // Validate the given spec, returning an error on validation
@@ -52,6 +59,23 @@ func (s LocalStorageSpec) Validate() error {
 			return errors.WithStack(errors.Wrapf(ValidationError, "localPath cannot contain empty strings"))
 		}
 	}
+	if err := s.SizeEnforcement.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+	if s.SizeEnforcement.Get() == LocalStorageSizeEnforcementProjectQuota && !s.GetPrivileged() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "sizeEnforcement %s requires privileged provisioner", LocalStorageSizeEnforcementProjectQuota))
+	}
+	if err := s.Backend.Validate(); err != nil {
+		return errors.WithStack(errors.Wrapf(err, "backend"))
+	}
+	if t := s.Backend.GetType(); t != LocalStorageBackendDirectory {
+		if !s.GetPrivileged() {
+			return errors.WithStack(errors.Wrapf(ValidationError, "backend %s requires privileged provisioner", t))
+		}
+		if s.SizeEnforcement.IsEnforced() {
+			return errors.WithStack(errors.Wrapf(ValidationError, "sizeEnforcement %s is not supported by backend %s", s.SizeEnforcement.Get(), t))
+		}
+	}
 	return nil
 }
 
@@ -72,6 +96,10 @@ func (s LocalStorageSpec) ResetImmutableFields(target *LocalStorageSpec) []strin
 		target.LocalPath = s.LocalPath
 		result = append(result, "localPath")
 	}
+	if !reflect.DeepEqual(s.Backend, target.Backend) {
+		target.Backend = s.Backend
+		result = append(result, "backend")
+	}
 	// TODO NodeSelector
 	return result
 }
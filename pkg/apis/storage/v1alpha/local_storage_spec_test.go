@@ -53,3 +53,68 @@ func TestLocalStorageSpecReset(t *testing.T) {
 	assert.Equal(t, source.LocalPath, target.LocalPath)
 	assert.Equal(t, source.StorageClass.Name, target.StorageClass.Name)
 }
+
+// Test validation of size enforcement
+func TestLocalStorageSpecSizeEnforcement(t *testing.T) {
+	class := StorageClassSpec{"spec-name", true}
+	mode := func(m LocalStorageSizeEnforcement) *LocalStorageSizeEnforcement {
+		return &m
+	}
+	privileged := true
+
+	local := LocalStorageSpec{StorageClass: class, LocalPath: []string{"/a/path"}}
+	assert.NoError(t, local.Validate())
+	assert.False(t, local.SizeEnforcement.IsEnforced())
+
+	local.SizeEnforcement = mode(LocalStorageSizeEnforcementProjectQuota)
+	assert.True(t, IsValidation(local.Validate()), "should fail as project quotas require privileged provisioner")
+
+	local.Privileged = &privileged
+	assert.NoError(t, local.Validate())
+	assert.True(t, local.SizeEnforcement.IsEnforced())
+
+	local.SizeEnforcement = mode("Unknown")
+	assert.True(t, IsValidation(local.Validate()))
+}
+
+// Test validation of backend
+func TestLocalStorageSpecBackend(t *testing.T) {
+	class := StorageClassSpec{"spec-name", true}
+	backendType := func(b LocalStorageBackendType) *LocalStorageBackendType {
+		return &b
+	}
+	privileged := true
+
+	local := LocalStorageSpec{StorageClass: class, LocalPath: []string{"/a/path"}}
+	assert.NoError(t, local.Validate())
+	assert.Equal(t, LocalStorageBackendDirectory, local.Backend.GetType())
+	assert.Equal(t, DefaultLocalStorageFSType, local.Backend.GetFSType())
+
+	local.Backend = &LocalStorageBackendSpec{Type: backendType(LocalStorageBackendLoopFile)}
+	assert.True(t, IsValidation(local.Validate()), "should fail as loop devices require privileged provisioner")
+
+	local.Privileged = &privileged
+	assert.NoError(t, local.Validate())
+	assert.True(t, local.Backend.Type.IsBlock())
+
+	local.SizeEnforcement = new(LocalStorageSizeEnforcement)
+	*local.SizeEnforcement = LocalStorageSizeEnforcementProjectQuota
+	assert.True(t, IsValidation(local.Validate()), "should fail as project quotas require directory backend")
+	local.SizeEnforcement = nil
+
+	local.Backend = &LocalStorageBackendSpec{Type: backendType(LocalStorageBackendLVM)}
+	assert.True(t, IsValidation(local.Validate()), "should fail as volume group is missing")
+
+	local.Backend.LVM = &LocalStorageLVMSpec{VolumeGroup: "vg", ThinPool: "pool"}
+	assert.NoError(t, local.Validate())
+
+	local.Backend = &LocalStorageBackendSpec{Type: backendType("Unknown")}
+	assert.True(t, IsValidation(local.Validate()))
+
+	// Backend cannot be changed
+	source := LocalStorageSpec{StorageClass: class, LocalPath: []string{"/a/path"}}
+	target := source
+	target.Backend = &LocalStorageBackendSpec{Type: backendType(LocalStorageBackendLoopFile)}
+	assert.Equal(t, []string{"backend"}, source.ResetImmutableFields(&target))
+	assert.Nil(t, target.Backend)
+}
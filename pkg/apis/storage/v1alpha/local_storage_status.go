@@ -27,4 +27,33 @@ type LocalStorageStatus struct {
 	State LocalStorageState `json:"state,omitempty"`
 	// Reason for the state this object is in.
 	Reason string `json:"reason,omitempty"`
+	// Nodes holds the inventory of the local paths on the nodes
+	Nodes LocalStorageNodeStatusList `json:"nodes,omitempty"`
+}
+
+// LocalStorageNodeStatusList is a list of node inventories
+type LocalStorageNodeStatusList []LocalStorageNodeStatus
+
+// LocalStorageNodeStatus contains the inventory of the local paths on the node
+type LocalStorageNodeStatus struct {
+	// NodeName is the name of the node
+	NodeName string `json:"nodeName"`
+	// Paths holds the inventory of the local paths on the node
+	Paths []LocalStoragePathStatus `json:"paths,omitempty"`
+}
+
+// LocalStoragePathStatus contains the inventory of the local path on the node
+type LocalStoragePathStatus struct {
+	// Path is the local path
+	Path string `json:"path"`
+	// Capacity of the filesystem containing the local path in bytes
+	Capacity int64 `json:"capacity"`
+	// Available space of the filesystem containing the local path in bytes
+	Available int64 `json:"available"`
+	// Volumes is the number of PersistentVolumes created in the local path
+	Volumes int `json:"volumes"`
+	// Bound is the number of PersistentVolumes bound to a claim
+	Bound int `json:"bound"`
+	// Released is the number of PersistentVolumes released and waiting for the cleanup
+	Released int `json:"released"`
 }
@@ -35,7 +35,7 @@ func (in *ArangoLocalStorage) DeepCopyInto(out *ArangoLocalStorage) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -90,6 +90,112 @@ func (in *ArangoLocalStorageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStorageBackendSpec) DeepCopyInto(out *LocalStorageBackendSpec) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(LocalStorageBackendType)
+		**out = **in
+	}
+	if in.FSType != nil {
+		in, out := &in.FSType, &out.FSType
+		*out = new(string)
+		**out = **in
+	}
+	if in.LVM != nil {
+		in, out := &in.LVM, &out.LVM
+		*out = new(LocalStorageLVMSpec)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageBackendSpec.
+func (in *LocalStorageBackendSpec) DeepCopy() *LocalStorageBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStorageLVMSpec) DeepCopyInto(out *LocalStorageLVMSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageLVMSpec.
+func (in *LocalStorageLVMSpec) DeepCopy() *LocalStorageLVMSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageLVMSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStorageNodeStatus) DeepCopyInto(out *LocalStorageNodeStatus) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]LocalStoragePathStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageNodeStatus.
+func (in *LocalStorageNodeStatus) DeepCopy() *LocalStorageNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in LocalStorageNodeStatusList) DeepCopyInto(out *LocalStorageNodeStatusList) {
+	{
+		in := &in
+		*out = make(LocalStorageNodeStatusList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageNodeStatusList.
+func (in LocalStorageNodeStatusList) DeepCopy() LocalStorageNodeStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageNodeStatusList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStoragePathStatus) DeepCopyInto(out *LocalStoragePathStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStoragePathStatus.
+func (in *LocalStoragePathStatus) DeepCopy() *LocalStoragePathStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStoragePathStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalStoragePodCustomization) DeepCopyInto(out *LocalStoragePodCustomization) {
 	*out = *in
@@ -137,6 +243,16 @@ func (in *LocalStorageSpec) DeepCopyInto(out *LocalStorageSpec) {
 		*out = new(LocalStoragePodCustomization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SizeEnforcement != nil {
+		in, out := &in.SizeEnforcement, &out.SizeEnforcement
+		*out = new(LocalStorageSizeEnforcement)
+		**out = **in
+	}
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(LocalStorageBackendSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -153,6 +269,13 @@ func (in *LocalStorageSpec) DeepCopy() *LocalStorageSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalStorageStatus) DeepCopyInto(out *LocalStorageStatus) {
 	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make(LocalStorageNodeStatusList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
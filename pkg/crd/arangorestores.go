@@ -0,0 +1,101 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package crd
+
+import (
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func init() {
+	registerCRDWithPanic("arangorestores.backup.arangodb.com", crd{
+		version: "1.0.0",
+		spec: apiextensions.CustomResourceDefinitionSpec{
+			Group: "backup.arangodb.com",
+			Names: apiextensions.CustomResourceDefinitionNames{
+				Plural:   "arangorestores",
+				Singular: "arangorestore",
+				Kind:     "ArangoRestore",
+				ListKind: "ArangoRestoreList",
+				ShortNames: []string{
+					"arangorestore",
+				},
+			},
+			Scope: apiextensions.NamespaceScoped,
+			Versions: []apiextensions.CustomResourceDefinitionVersion{
+				{
+					Name: "v1",
+					Schema: &apiextensions.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+							Type:                   "object",
+							XPreserveUnknownFields: util.NewBool(true),
+						},
+					},
+					Served:  true,
+					Storage: true,
+					AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+						{
+							JSONPath:    ".spec.deployment.name",
+							Description: "Deployment name",
+							Name:        "Deployment",
+							Type:        "string",
+						},
+						{
+							JSONPath:    ".spec.backup.name",
+							Description: "Backup name",
+							Name:        "Backup",
+							Type:        "string",
+						},
+						{
+							JSONPath:    ".status.phase",
+							Description: "The actual phase of the ArangoRestore",
+							Name:        "Phase",
+							Type:        "string",
+						},
+						{
+							JSONPath:    ".status.completionTime",
+							Description: "Completion time of the ArangoRestore",
+							Name:        "Completed",
+							Type:        "date",
+						},
+						{
+							JSONPath:    ".status.message",
+							Priority:    1,
+							Description: "Message of the ArangoRestore object",
+							Name:        "Message",
+							Type:        "string",
+						},
+						{
+							JSONPath:    ".metadata.creationTimestamp",
+							Description: "Creation time of the ArangoRestore",
+							Name:        "Age",
+							Type:        "date",
+						},
+					},
+					Subresources: &apiextensions.CustomResourceSubresources{
+						Status: &apiextensions.CustomResourceSubresourceStatus{},
+					},
+				},
+			},
+		},
+	})
+}
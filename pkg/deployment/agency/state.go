@@ -145,6 +145,23 @@ func (s State) PlanServers() Servers {
 	return r
 }
 
+// PlanServerShards returns number of the shard replicas planned on each server
+func (s State) PlanServerShards() map[Server]int {
+	r := map[Server]int{}
+
+	for _, db := range s.Plan.Collections {
+		for _, col := range db {
+			for _, shards := range col.Shards {
+				for _, shard := range shards {
+					r[shard]++
+				}
+			}
+		}
+	}
+
+	return r
+}
+
 type CollectionShardDetails []CollectionShardDetail
 
 type CollectionShardDetail struct {
@@ -191,23 +208,34 @@ func GetDBServerBlockingRestartShards(s State, serverID Server) CollectionShardD
 	return s.Filter(FilterDBServerShardRestart(serverID))
 }
 
+// GetDBServersBlockingRestartShards returns shards which are blocking restart of all given servers at the same time
+func GetDBServersBlockingRestartShards(s State, serverIDs ...Server) CollectionShardDetails {
+	return s.Filter(FilterDBServersShardRestart(serverIDs...))
+}
+
 func FilterDBServerShardRestart(serverID Server) StateShardFilter {
+	return FilterDBServersShardRestart(serverID)
+}
+
+func FilterDBServersShardRestart(serverIDs ...Server) StateShardFilter {
 	return NegateFilter(func(s State, db, col, shard string) bool {
-		// Filter all shards which are not blocking restart of server
+		// Filter all shards which are not blocking restart of servers
 		plan := s.Plan.Collections[db][col]
 		planShard := plan.Shards[shard]
 
-		if !planShard.Contains(serverID) {
-			// This DBServer is not even in plan, restart possible
+		restarted := planShard.Join(serverIDs)
+
+		if len(restarted) == 0 {
+			// None of the DBServers is even in plan, restart possible
 			return true
 		}
 
 		current := s.Current.Collections[db][col][shard]
 		currentShard := current.Servers.Join(planShard)
 
-		serverInSync := currentShard.Contains(serverID)
+		serversInSync := currentShard.Join(restarted)
 
-		if len(planShard) == 1 && serverInSync {
+		if len(planShard) == 1 && len(serversInSync) > 0 {
 			// The requested server is the only one in the plan, restart possible
 			return true
 		}
@@ -230,18 +258,12 @@ func FilterDBServerShardRestart(serverID Server) StateShardFilter {
 			wc = rf - 1
 		}
 
-		if len(currentShard) >= wc && !serverInSync {
-			// Current shard is not in sync, but it does not matter - we have enough replicas in sync
-			// Restart of this DBServer won't affect WC
-			return true
-		}
-
-		if len(currentShard) > wc {
-			// We are in plan, but restart is possible
+		if len(currentShard)-len(serversInSync) >= wc {
+			// Enough replicas stay in sync after restart of the servers, it won't affect WC
 			return true
 		}
 
-		// If we restart this server, write concern won't be satisfied
+		// If we restart these servers, write concern won't be satisfied
 		return false
 	})
 }
@@ -297,3 +297,77 @@ func Test_IsDBServerReadyToRestart(t *testing.T) {
 		})
 	}
 }
+
+func Test_AreDBServersReadyToRestart(t *testing.T) {
+	type testCase struct {
+		generator StateGenerator
+		ready     [][]string
+		notReady  [][]string
+	}
+	newDBWithCol := func(writeConcern int) CollectionGeneratorInterface {
+		return NewDatabaseRandomGenerator().RandomCollection().WithWriteConcern(writeConcern)
+	}
+	tcs := map[string]testCase{
+		"not in Plan": {
+			generator: newDBWithCol(1).WithShard().WithPlan("A", "B").WithCurrent("A", "B").Add().Add().Add(),
+			ready:     [][]string{{"C", "D"}},
+		},
+		"in Plan, WC = 1, RF = 3": {
+			generator: newDBWithCol(1).WithShard().WithPlan("A", "B", "C").WithCurrent("A", "B", "C").Add().Add().Add(),
+			ready:     [][]string{{"A"}, {"A", "B"}, {"B", "C"}},
+			notReady:  [][]string{{"A", "B", "C"}},
+		},
+		"in Plan, WC = 2, RF = 3": {
+			generator: newDBWithCol(2).WithShard().WithPlan("A", "B", "C").WithCurrent("A", "B", "C").Add().Add().Add(),
+			ready:     [][]string{{"A"}, {"C", "D"}},
+			notReady:  [][]string{{"A", "B"}, {"B", "C"}},
+		},
+		"in Plan, one server not in sync": {
+			generator: newDBWithCol(1).WithShard().WithPlan("A", "B", "C").WithCurrent("A", "B").Add().Add().Add(),
+			ready:     [][]string{{"A", "C"}},
+			notReady:  [][]string{{"A", "B"}},
+		},
+		"different shards": {
+			generator: newDBWithCol(1).
+				WithShard().WithPlan("A", "B").WithCurrent("A", "B").Add().
+				WithShard().WithPlan("C", "D").WithCurrent("C", "D").Add().
+				Add().Add(),
+			ready:    [][]string{{"A", "C"}, {"B", "D"}},
+			notReady: [][]string{{"A", "B"}, {"C", "D"}},
+		},
+	}
+
+	asServers := func(ids []string) Servers {
+		r := make(Servers, len(ids))
+		for id := range ids {
+			r[id] = Server(ids[id])
+		}
+		return r
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			s := GenerateState(t, tc.generator)
+			for _, servers := range tc.ready {
+				require.Len(t, GetDBServersBlockingRestartShards(s, asServers(servers)...), 0, "servers %v should be ready to restart", servers)
+			}
+			for _, servers := range tc.notReady {
+				require.NotEqual(t, len(GetDBServersBlockingRestartShards(s, asServers(servers)...)), 0, "servers %v should not be ready to restart", servers)
+			}
+		})
+	}
+}
+
+func Test_PlanServerShards(t *testing.T) {
+	s := GenerateState(t, NewDatabaseRandomGenerator().RandomCollection().
+		WithShard().WithPlan("A", "B").Add().
+		WithShard().WithPlan("A", "C").Add().
+		WithShard().WithPlan("A").Add().Add().Add())
+
+	shards := s.PlanServerShards()
+
+	require.Equal(t, 3, shards["A"])
+	require.Equal(t, 1, shards["B"])
+	require.Equal(t, 1, shards["C"])
+	require.Equal(t, 0, shards["D"])
+}
@@ -0,0 +1,146 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package agency
+
+import "sort"
+
+// ServerZones keeps zone of each server
+type ServerZones map[Server]string
+
+// Zones returns sorted list of the known zones
+func (s ServerZones) Zones() []string {
+	m := map[string]bool{}
+
+	for _, z := range s {
+		if z != "" {
+			m[z] = true
+		}
+	}
+
+	r := make([]string, 0, len(m))
+	for z := range m {
+		r = append(r, z)
+	}
+
+	sort.Strings(r)
+
+	return r
+}
+
+// Get returns zones of the servers. Returns false if zone of any server is unknown.
+func (s ServerZones) Get(servers Servers) ([]string, bool) {
+	r := make([]string, len(servers))
+
+	for id, server := range servers {
+		z, ok := s[server]
+		if !ok || z == "" {
+			return nil, false
+		}
+
+		r[id] = z
+	}
+
+	return r, true
+}
+
+// ShardZoneViolation keeps shard which replicas are not spread across zones
+type ShardZoneViolation struct {
+	Database   string   `json:"database"`
+	Collection string   `json:"collection"`
+	Shard      string   `json:"shard"`
+	Servers    Servers  `json:"servers"`
+	Zones      []string `json:"zones"`
+}
+
+// GetShardsZoneViolations returns shards which replicas are placed in fewer zones than possible.
+// Shards of the collections with distributeShardsLike are placed like shards of the prototype, so they are not reported.
+func (s State) GetShardsZoneViolations(zones ServerZones) []ShardZoneViolation {
+	available := len(zones.Zones())
+	if available < 2 {
+		return nil
+	}
+
+	var r []ShardZoneViolation
+
+	for db, collections := range s.Plan.Collections {
+		for colID, col := range collections {
+			if col.DistributeShardsLike != nil {
+				continue
+			}
+
+			for shard, servers := range col.Shards {
+				z, ok := zones.Get(servers)
+				if !ok {
+					continue
+				}
+
+				expected := len(servers)
+				if expected > available {
+					expected = available
+				}
+
+				if used := uniqueZones(z); len(used) < expected {
+					r = append(r, ShardZoneViolation{
+						Database:   db,
+						Collection: col.GetName(colID),
+						Shard:      shard,
+						Servers:    servers,
+						Zones:      used,
+					})
+				}
+			}
+		}
+	}
+
+	sort.Slice(r, func(i, j int) bool {
+		if r[i].Database != r[j].Database {
+			return r[i].Database < r[j].Database
+		}
+		if r[i].Collection != r[j].Collection {
+			return r[i].Collection < r[j].Collection
+		}
+		return r[i].Shard < r[j].Shard
+	})
+
+	return r
+}
+
+func uniqueZones(zones []string) []string {
+	r := make([]string, 0, len(zones))
+
+	for _, z := range zones {
+		found := false
+		for _, q := range r {
+			if q == z {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			r = append(r, z)
+		}
+	}
+
+	sort.Strings(r)
+
+	return r
+}
@@ -0,0 +1,82 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package agency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetShardsZoneViolations(t *testing.T) {
+	zones := ServerZones{
+		"A": "zone-a",
+		"B": "zone-a",
+		"C": "zone-b",
+		"D": "zone-c",
+	}
+
+	type testCase struct {
+		generator  StateGenerator
+		zones      ServerZones
+		violations int
+	}
+
+	newDBWithCol := func() CollectionGeneratorInterface {
+		return NewDatabaseRandomGenerator().RandomCollection()
+	}
+
+	tcs := map[string]testCase{
+		"Spread": {
+			generator:  newDBWithCol().WithShard().WithPlan("A", "C", "D").Add().WithShard().WithPlan("B", "C").Add().Add().Add(),
+			zones:      zones,
+			violations: 0,
+		},
+		"Single zone": {
+			generator:  newDBWithCol().WithShard().WithPlan("A", "B").Add().Add().Add(),
+			zones:      zones,
+			violations: 1,
+		},
+		"Two of three zones": {
+			generator:  newDBWithCol().WithShard().WithPlan("A", "B", "C").Add().WithShard().WithPlan("A").Add().Add().Add(),
+			zones:      zones,
+			violations: 1,
+		},
+		"Unknown zone": {
+			generator:  newDBWithCol().WithShard().WithPlan("A", "E").Add().Add().Add(),
+			zones:      zones,
+			violations: 0,
+		},
+		"One zone": {
+			generator:  newDBWithCol().WithShard().WithPlan("A", "B").Add().Add().Add(),
+			zones:      ServerZones{"A": "zone-a", "B": "zone-a"},
+			violations: 0,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			s := GenerateState(t, tc.generator)
+
+			require.Len(t, s.GetShardsZoneViolations(tc.zones), tc.violations)
+		})
+	}
+}
@@ -43,6 +43,7 @@ func NewClient(c driver.Connection, log logging.Logger) Client {
 type Client interface {
 	LicenseClient
 	MaintenanceClient
+	EngineClient
 
 	GetTLS(ctx context.Context) (TLSDetails, error)
 	RefreshTLS(ctx context.Context) (TLSDetails, error)
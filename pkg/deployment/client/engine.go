@@ -0,0 +1,75 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+const EngineStatsUrl = "/_api/engine/stats"
+
+type EngineClient interface {
+	GetEngineStats(ctx context.Context) (EngineStats, error)
+}
+
+// EngineStats contains disk statistics of the RocksDB storage engine
+type EngineStats struct {
+	// TotalDiskSpace is the size of the filesystem on which the data directory is placed
+	TotalDiskSpace int64 `json:"rocksdb.total-disk-space"`
+	// FreeDiskSpace is the free space of the filesystem on which the data directory is placed
+	FreeDiskSpace int64 `json:"rocksdb.free-disk-space"`
+	// LiveSSTFilesSize is the size of the data stored in the live SST files
+	LiveSSTFilesSize int64 `json:"rocksdb.live-sst-files-size"`
+}
+
+// UsedDiskSpace returns space used on the filesystem on which the data directory is placed
+func (e EngineStats) UsedDiskSpace() int64 {
+	if e.TotalDiskSpace < e.FreeDiskSpace {
+		return 0
+	}
+
+	return e.TotalDiskSpace - e.FreeDiskSpace
+}
+
+func (c *client) GetEngineStats(ctx context.Context) (EngineStats, error) {
+	req, err := c.c.NewRequest(http.MethodGet, EngineStatsUrl)
+	if err != nil {
+		return EngineStats{}, err
+	}
+
+	resp, err := c.c.Do(ctx, req)
+	if err != nil {
+		return EngineStats{}, err
+	}
+
+	if err := resp.CheckStatus(http.StatusOK); err != nil {
+		return EngineStats{}, err
+	}
+
+	var s EngineStats
+
+	if err := resp.ParseBody("", &s); err != nil {
+		return EngineStats{}, err
+	}
+
+	return s, nil
+}
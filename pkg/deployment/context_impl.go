@@ -58,6 +58,7 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	arangorestorev1 "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangorestore/v1"
 	persistentvolumeclaimv1 "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/persistentvolumeclaim/v1"
 	podv1 "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/pod/v1"
 	poddisruptionbudgetv1beta1 "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/poddisruptionbudget/v1beta1"
@@ -78,6 +79,40 @@ func (d *Deployment) GetBackup(ctx context.Context, backup string) (*backupApi.A
 	return d.deps.Client.Arango().BackupV1().ArangoBackups(d.Namespace()).Get(ctxChild, backup, meta.GetOptions{})
 }
 
+// GetRestore receives information about a restore resource
+func (d *Deployment) GetRestore(ctx context.Context, restore string) (*backupApi.ArangoRestore, error) {
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+
+	return d.deps.Client.Arango().BackupV1().ArangoRestores(d.Namespace()).Get(ctxChild, restore, meta.GetOptions{})
+}
+
+// ListRestores returns restore resources which target the deployment
+func (d *Deployment) ListRestores(ctx context.Context) ([]backupApi.ArangoRestore, error) {
+	cache, err := d.GetCachedStatus().ArangoRestore().V1()
+	if err != nil {
+		return nil, err
+	}
+
+	restores := cache.Filter(arangorestorev1.FilterByDeploymentName(d.name))
+
+	r := make([]backupApi.ArangoRestore, 0, len(restores))
+	for _, restore := range restores {
+		r = append(r, *restore.DeepCopy())
+	}
+
+	return r, nil
+}
+
+// UpdateRestoreStatus updates the status of the restore resource
+func (d *Deployment) UpdateRestoreStatus(ctx context.Context, restore *backupApi.ArangoRestore) error {
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+
+	_, err := d.GetCachedStatus().ArangoRestoresModInterface().V1().UpdateStatus(ctxChild, restore, meta.UpdateOptions{})
+	return err
+}
+
 // GetAPIObject returns the deployment as k8s object.
 func (d *Deployment) GetAPIObject() k8sutil.APIObject {
 	return d.currentObject
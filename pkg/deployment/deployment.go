@@ -123,6 +123,8 @@ type Deployment struct {
 	agencyCache               agency.Cache
 	recentInspectionErrors    int
 	clusterScalingIntegration *clusterScalingIntegration
+	autoscaler                *autoscaler
+	skippedMemberGroupScales  map[string]int64
 	reconciler                *reconcile.Reconciler
 	resilience                *resilience.Resilience
 	resources                 *resources.Resources
@@ -273,6 +275,7 @@ func New(config Config, deps Dependencies, apiObject *api.ArangoDeployment) (*De
 		ci := newClusterScalingIntegration(d)
 		d.clusterScalingIntegration = ci
 		go ci.ListenForClusterEvents(d.stopCh)
+		d.autoscaler = newAutoscaler(d)
 	}
 	if config.AllowChaos {
 		d.chaosMonkey = chaos.NewMonkey(apiObject.GetNamespace(), apiObject.GetName(), d)
@@ -0,0 +1,374 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/arangodb/go-driver"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
+	"github.com/arangodb/kube-arangodb/pkg/generated/metric_descriptions"
+	"github.com/arangodb/kube-arangodb/pkg/logging"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
+)
+
+var asLogger = logging.Global().RegisterAndGetLogger("deployment-autoscaler", logging.Info)
+
+const (
+	// autoscalerSampleInterval defines how often statistics of the servers are sampled
+	autoscalerSampleInterval = 15 * time.Second
+	// autoscalerTolerance defines the ratio of the target change which is ignored
+	autoscalerTolerance = 0.1
+
+	autoscalerMetricRequestsInProgress   = "requestsInProgress"
+	autoscalerMetricSchedulerQueueLength = "schedulerQueueLength"
+	autoscalerMetricClientConnections    = "clientConnections"
+)
+
+// autoscalerSample keeps average values of the statistics sampled from servers of the group
+type autoscalerSample struct {
+	Servers int
+
+	RequestsInProgress   float64
+	SchedulerQueueLength float64
+	ClientConnections    float64
+}
+
+type autoscalerRecommendation struct {
+	time  time.Time
+	count int
+}
+
+// autoscaler changes the count of Coordinators based on the statistics of the servers.
+// Inspect is called only from the inspection loop, lock protects metrics which are read by CollectMetrics.
+type autoscaler struct {
+	log  logging.Logger
+	depl *Deployment
+
+	lock sync.Mutex
+
+	lastSample      time.Time
+	lastScale       time.Time
+	lastSkipped     int
+	recommendations []autoscalerRecommendation
+
+	metrics struct {
+		sample     *autoscalerSample
+		desired    int
+		scaleUps   uint64
+		scaleDowns uint64
+	}
+}
+
+func (a *autoscaler) WrapLogger(in *zerolog.Event) *zerolog.Event {
+	return in.Str("namespace", a.depl.GetNamespace()).Str("name", a.depl.Name())
+}
+
+// newAutoscaler creates a new autoscaler.
+func newAutoscaler(depl *Deployment) *autoscaler {
+	a := &autoscaler{
+		depl: depl,
+	}
+	a.log = asLogger.WrapObj(a)
+	return a
+}
+
+// Inspect samples statistics of the Coordinators and changes the count of the group when needed.
+// Servers are sampled without holding the lock, so metrics collection is not blocked by the requests.
+func (a *autoscaler) Inspect(ctx context.Context) error {
+	group := api.ServerGroupCoordinators
+	spec := a.depl.currentObject.Spec
+	groupSpec := spec.GetServerGroupSpec(group)
+	autoscaling := groupSpec.GetAutoscaling()
+
+	if spec.GetMode() != api.DeploymentModeCluster || !autoscaling.IsEnabled() {
+		a.reset()
+		return nil
+	}
+
+	now := time.Now()
+
+	if now.Sub(a.lastSample) < autoscalerSampleInterval {
+		return nil
+	}
+
+	a.lastSample = now
+
+	status := a.depl.GetStatus()
+	members := status.Members.MembersOfGroup(group)
+	current := groupSpec.GetCount()
+
+	if !status.Plan.IsEmpty() || len(members) != current {
+		// Wait until the group is scaled and all actions are done
+		return nil
+	}
+
+	sample, err := a.sample(ctx, group, members)
+	if err != nil {
+		return err
+	}
+
+	if sample.Servers == 0 {
+		return nil
+	}
+
+	desired, reason := autoscalerDesiredCount(autoscaling, sample, current)
+	desired = boundArangoMemberGroupReplicas(desired, groupSpec)
+
+	a.withMetrics(func() {
+		a.metrics.sample = &sample
+		a.metrics.desired = desired
+	})
+
+	a.recommendations = append(a.recommendations, autoscalerRecommendation{time: now, count: desired})
+	a.recommendations = autoscalerPruneRecommendations(a.recommendations, now, autoscaling)
+
+	expected := autoscalerStabilizedCount(a.recommendations, now, current, autoscaling)
+
+	if expected == current {
+		return nil
+	}
+
+	if expected > current {
+		if now.Sub(a.lastScale) < autoscaling.GetScaleUpCooldown() {
+			return nil
+		}
+	} else {
+		if now.Sub(a.lastScale) < autoscaling.GetScaleDownCooldown() {
+			return nil
+		}
+	}
+
+	if api.IsReconciliationPaused(a.depl.currentObject, spec) {
+		// Spec is not changed while reconciliation is paused, skipped count is recorded once
+		if a.lastSkipped != expected {
+			a.lastSkipped = expected
+			a.log.Int("from", current).Int("to", expected).Str("reason", reason).Info("Scaling Coordinators skipped, reconciliation is paused")
+			a.depl.CreateEvent(k8sutil.NewGroupScaleSkippedEvent(a.depl.currentObject, group.AsRole(), current, expected, "autoscaler"))
+		}
+
+		return nil
+	}
+
+	a.lastSkipped = 0
+
+	a.log.Int("from", current).Int("to", expected).Str("reason", reason).Info("Scaling Coordinators")
+
+	if err := a.depl.ApplyPatch(ctx, patch.ItemReplace(patch.NewPath("spec", "coordinators", "count"), expected)); err != nil {
+		return errors.Wrapf(err, "Unable to change count of the Coordinators")
+	}
+
+	a.lastScale = now
+
+	a.withMetrics(func() {
+		if expected > current {
+			a.metrics.scaleUps++
+		} else {
+			a.metrics.scaleDowns++
+		}
+	})
+
+	a.depl.CreateEvent(k8sutil.NewGroupAutoscaledEvent(a.depl.currentObject, group.AsRole(), current, expected, reason))
+
+	return nil
+}
+
+// sample returns average statistics of the ready servers
+func (a *autoscaler) sample(ctx context.Context, group api.ServerGroup, members api.MemberStatusList) (autoscalerSample, error) {
+	var sample autoscalerSample
+
+	for _, m := range members {
+		if !m.Conditions.IsTrue(api.ConditionTypeReady) {
+			continue
+		}
+
+		c, err := a.depl.GetServerClient(ctx, group, m.ID)
+		if err != nil {
+			a.log.Err(err).Str("member", m.ID).Debug("Unable to get client")
+			continue
+		}
+
+		var stats driver.ServerStatistics
+
+		err = globals.GetGlobalTimeouts().ArangoD().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+			s, err := c.Statistics(ctxChild)
+			if err != nil {
+				return err
+			}
+
+			stats = s
+			return nil
+		})
+		if err != nil {
+			a.log.Err(err).Str("member", m.ID).Debug("Unable to get statistics")
+			continue
+		}
+
+		sample.Servers++
+		sample.RequestsInProgress += float64(stats.Server.Threads.InProgress)
+		sample.SchedulerQueueLength += float64(stats.Server.Threads.Queued)
+		sample.ClientConnections += float64(stats.Client.HTTPConnections)
+	}
+
+	if sample.Servers > 0 {
+		sample.RequestsInProgress /= float64(sample.Servers)
+		sample.SchedulerQueueLength /= float64(sample.Servers)
+		sample.ClientConnections /= float64(sample.Servers)
+	}
+
+	return sample, nil
+}
+
+func (a *autoscaler) reset() {
+	a.recommendations = nil
+
+	a.withMetrics(func() {
+		a.metrics.sample = nil
+		a.metrics.desired = 0
+	})
+}
+
+// withMetrics executes f with the lock held
+func (a *autoscaler) withMetrics(f func()) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	f()
+}
+
+func (a *autoscaler) CollectMetrics(m metrics.PushMetric) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	namespace, name, role := a.depl.GetNamespace(), a.depl.GetName(), api.ServerGroupCoordinators.AsRole()
+
+	m.Push(metric_descriptions.ArangodbOperatorAutoscalerScaleOperationsCounter(float64(a.metrics.scaleUps), namespace, name, role, "up"))
+	m.Push(metric_descriptions.ArangodbOperatorAutoscalerScaleOperationsCounter(float64(a.metrics.scaleDowns), namespace, name, role, "down"))
+
+	if s := a.metrics.sample; s != nil {
+		m.Push(metric_descriptions.ArangodbOperatorAutoscalerDesiredCountGauge(float64(a.metrics.desired), namespace, name, role))
+		m.Push(metric_descriptions.ArangodbOperatorAutoscalerMetricValueGauge(s.RequestsInProgress, namespace, name, role, autoscalerMetricRequestsInProgress))
+		m.Push(metric_descriptions.ArangodbOperatorAutoscalerMetricValueGauge(s.SchedulerQueueLength, namespace, name, role, autoscalerMetricSchedulerQueueLength))
+		m.Push(metric_descriptions.ArangodbOperatorAutoscalerMetricValueGauge(s.ClientConnections, namespace, name, role, autoscalerMetricClientConnections))
+	}
+}
+
+// autoscalerDesiredCount returns count of the servers for which all targets are met, together with the reason.
+// Changes of the ratio within tolerance are ignored.
+func autoscalerDesiredCount(spec *api.ServerGroupAutoscalingSpec, sample autoscalerSample, current int) (int, string) {
+	desired := -1
+	reason := "all targets are met"
+
+	for _, t := range []struct {
+		name   string
+		target *int
+		value  float64
+	}{
+		{autoscalerMetricRequestsInProgress, spec.TargetRequestsInProgress, sample.RequestsInProgress},
+		{autoscalerMetricSchedulerQueueLength, spec.TargetSchedulerQueueLength, sample.SchedulerQueueLength},
+		{autoscalerMetricClientConnections, spec.TargetClientConnections, sample.ClientConnections},
+	} {
+		if t.target == nil {
+			continue
+		}
+
+		count := current
+
+		if ratio := t.value / float64(*t.target); math.Abs(ratio-1) > autoscalerTolerance {
+			count = int(math.Ceil(ratio * float64(sample.Servers)))
+		}
+
+		if count > desired {
+			desired = count
+			reason = fmt.Sprintf("average %s %.2f, target %d", t.name, t.value, *t.target)
+		}
+	}
+
+	if desired < 0 {
+		return current, reason
+	}
+
+	return desired, reason
+}
+
+// autoscalerPruneRecommendations removes recommendations which are outside of stabilization windows
+func autoscalerPruneRecommendations(recommendations []autoscalerRecommendation, now time.Time, spec *api.ServerGroupAutoscalingSpec) []autoscalerRecommendation {
+	window := spec.GetScaleUpStabilizationWindow()
+	if w := spec.GetScaleDownStabilizationWindow(); w > window {
+		window = w
+	}
+
+	r := make([]autoscalerRecommendation, 0, len(recommendations))
+
+	for _, rec := range recommendations {
+		if now.Sub(rec.time) <= window {
+			r = append(r, rec)
+		}
+	}
+
+	return r
+}
+
+// autoscalerStabilizedCount returns count of the servers based on the recommendations within stabilization windows.
+// Group is scaled up to the lowest recommendation within scale up window and scaled down to the highest
+// recommendation within scale down window.
+func autoscalerStabilizedCount(recommendations []autoscalerRecommendation, now time.Time, current int, spec *api.ServerGroupAutoscalingSpec) int {
+	if len(recommendations) == 0 {
+		return current
+	}
+
+	upWindow, downWindow := spec.GetScaleUpStabilizationWindow(), spec.GetScaleDownStabilizationWindow()
+
+	up, down := math.MaxInt32, math.MinInt32
+
+	for _, rec := range recommendations {
+		age := now.Sub(rec.time)
+
+		if age <= upWindow && rec.count < up {
+			up = rec.count
+		}
+
+		if age <= downWindow && rec.count > down {
+			down = rec.count
+		}
+	}
+
+	if up != math.MaxInt32 && up > current {
+		return up
+	}
+
+	if down != math.MinInt32 && down < current {
+		return down
+	}
+
+	return current
+}
@@ -0,0 +1,111 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_AutoscalerDesiredCount(t *testing.T) {
+	spec := &api.ServerGroupAutoscalingSpec{
+		TargetRequestsInProgress: util.NewInt(10),
+		TargetClientConnections:  util.NewInt(100),
+	}
+
+	t.Run("Within tolerance", func(t *testing.T) {
+		c, _ := autoscalerDesiredCount(spec, autoscalerSample{Servers: 3, RequestsInProgress: 10.5, ClientConnections: 95}, 3)
+		require.Equal(t, 3, c)
+	})
+
+	t.Run("Scale up", func(t *testing.T) {
+		c, _ := autoscalerDesiredCount(spec, autoscalerSample{Servers: 3, RequestsInProgress: 20, ClientConnections: 50}, 3)
+		require.Equal(t, 6, c)
+	})
+
+	t.Run("Highest recommendation wins", func(t *testing.T) {
+		c, _ := autoscalerDesiredCount(spec, autoscalerSample{Servers: 3, RequestsInProgress: 20, ClientConnections: 300}, 3)
+		require.Equal(t, 9, c)
+	})
+
+	t.Run("Scale down", func(t *testing.T) {
+		c, _ := autoscalerDesiredCount(spec, autoscalerSample{Servers: 4, RequestsInProgress: 4, ClientConnections: 40}, 4)
+		require.Equal(t, 2, c)
+	})
+
+	t.Run("Ignore not defined targets", func(t *testing.T) {
+		c, _ := autoscalerDesiredCount(spec, autoscalerSample{Servers: 3, RequestsInProgress: 10, ClientConnections: 100, SchedulerQueueLength: 1000}, 3)
+		require.Equal(t, 3, c)
+	})
+}
+
+func Test_AutoscalerStabilizedCount(t *testing.T) {
+	now := time.Now()
+
+	spec := &api.ServerGroupAutoscalingSpec{
+		ScaleUp: &api.ServerGroupAutoscalingBehaviour{
+			StabilizationWindowSeconds: util.NewInt(45),
+		},
+		ScaleDown: &api.ServerGroupAutoscalingBehaviour{
+			StabilizationWindowSeconds: util.NewInt(300),
+		},
+	}
+
+	recs := func(counts ...int) []autoscalerRecommendation {
+		r := make([]autoscalerRecommendation, len(counts))
+		for id, c := range counts {
+			r[id] = autoscalerRecommendation{
+				time:  now.Add(-time.Duration(len(counts)-id-1) * 30 * time.Second),
+				count: c,
+			}
+		}
+		return r
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		require.Equal(t, 3, autoscalerStabilizedCount(nil, now, 3, spec))
+	})
+
+	t.Run("Scale up to lowest recommendation in window", func(t *testing.T) {
+		require.Equal(t, 5, autoscalerStabilizedCount(recs(3, 5, 6), now, 3, spec))
+	})
+
+	t.Run("Scale up ignores recommendations outside of window", func(t *testing.T) {
+		require.Equal(t, 5, autoscalerStabilizedCount(recs(3, 3, 5, 6), now, 3, spec))
+	})
+
+	t.Run("Scale down to highest recommendation in window", func(t *testing.T) {
+		require.Equal(t, 5, autoscalerStabilizedCount(recs(5, 2, 2), now, 6, spec))
+	})
+
+	t.Run("Scale down blocked by recent recommendation", func(t *testing.T) {
+		require.Equal(t, 6, autoscalerStabilizedCount(recs(6, 2, 2), now, 6, spec))
+	})
+
+	t.Run("Prune", func(t *testing.T) {
+		require.Len(t, autoscalerPruneRecommendations(recs(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12), now, spec), 11)
+	})
+}
@@ -249,6 +249,16 @@ func (d *Deployment) inspectDeploymentWithError(ctx context.Context, lastInterva
 		return minInspectionInterval, errors.Wrapf(err, "ArangoMember creation failed")
 	}
 
+	if err := d.inspectArangoMemberGroups(ctx, d.GetCachedStatus()); err != nil {
+		return minInspectionInterval, errors.Wrapf(err, "ArangoMemberGroup inspection failed")
+	}
+
+	if a := d.autoscaler; a != nil {
+		if err := a.Inspect(ctx); err != nil {
+			d.log.Err(err).Warn("Autoscaler inspection failed")
+		}
+	}
+
 	if err := d.resources.EnsureServices(ctx, d.GetCachedStatus()); err != nil {
 		return minInspectionInterval, errors.Wrapf(err, "Service creation failed")
 	}
@@ -319,6 +329,32 @@ func (d *Deployment) inspectDeploymentWithError(ctx context.Context, lastInterva
 	// Refresh maintenance lock
 	d.refreshMaintenanceTTL(ctx)
 
+	// Cancel pending plans
+	if _, ok := d.currentObject.Annotations[deployment.ArangoDeploymentPlanCancelAnnotation]; ok {
+		if err := d.ApplyPatch(ctx, patch.ItemRemove(patch.NewPath("metadata", "annotations", deployment.ArangoDeploymentPlanCancelAnnotation))); err != nil {
+			return minInspectionInterval, errors.Wrapf(err, "Unable to create remove annotation patch")
+		}
+
+		if err := d.reconciler.CancelPlan(ctx); err != nil {
+			return minInspectionInterval, errors.Wrapf(err, "Unable to cancel plan")
+		}
+
+		return minInspectionInterval, nil
+	}
+
+	// Paused state ensurer
+	if paused := api.IsReconciliationPaused(d.currentObject, d.GetSpec()); paused != status.Conditions.IsTrue(api.ConditionTypePaused) {
+		if paused {
+			if err = d.updateConditionWithHash(ctx, api.ConditionTypePaused, true, "Reconciliation Paused", "New plan actions are not started", ""); err != nil {
+				return minInspectionInterval, errors.Wrapf(err, "Unable to update Paused condition")
+			}
+		} else {
+			if err = d.updateConditionWithHash(ctx, api.ConditionTypePaused, false, "Reconciliation Resumed", "", ""); err != nil {
+				return minInspectionInterval, errors.Wrapf(err, "Unable to update Paused condition")
+			}
+		}
+	}
+
 	// Create scale/update plan
 	if _, ok := d.currentObject.Annotations[deployment.ArangoDeploymentPlanCleanAnnotation]; ok {
 		if err := d.ApplyPatch(ctx, patch.ItemRemove(patch.NewPath("metadata", "annotations", deployment.ArangoDeploymentPlanCleanAnnotation))); err != nil {
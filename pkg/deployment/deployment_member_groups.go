@@ -0,0 +1,200 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+)
+
+// inspectArangoMemberGroups ensures that ArangoMemberGroups of the scalable groups exist and keeps them
+// in sync with the count of the group in the deployment spec.
+// Change of the replicas (e.g. by the HorizontalPodAutoscaler) is propagated to the count of the group
+// within minCount and maxCount bounds, so the regular scaling plan (with CleanOutMember for DBServers) is used.
+func (d *Deployment) inspectArangoMemberGroups(ctx context.Context, cachedStatus inspectorInterface.Inspector) error {
+	spec := d.currentObject.Spec
+
+	if spec.GetMode() != api.DeploymentModeCluster {
+		return nil
+	}
+
+	inspector, err := cachedStatus.ArangoMemberGroup().V1()
+	if err != nil {
+		d.log.Err(err).Trace("ArangoMemberGroups are not available")
+		return nil
+	}
+
+	status := d.GetStatus()
+	client := cachedStatus.ArangoMemberGroupsModInterface().V1()
+	paused := api.IsReconciliationPaused(d.currentObject, spec)
+
+	if d.skippedMemberGroupScales == nil {
+		d.skippedMemberGroupScales = map[string]int64{}
+	}
+
+	var p []patch.Item
+	statuses := map[string]api.ArangoMemberGroupStatus{}
+
+	for group, field := range map[api.ServerGroup]string{
+		api.ServerGroupCoordinators: "coordinators",
+		api.ServerGroupDBServers:    "dbservers",
+	} {
+		groupSpec := spec.GetServerGroupSpec(group)
+		name := api.ArangoMemberGroupName(d.GetName(), group)
+
+		amg, ok := inspector.GetSimple(name)
+		if !ok {
+			obj := &api.ArangoMemberGroup{
+				ObjectMeta: meta.ObjectMeta{
+					Name: name,
+					OwnerReferences: []meta.OwnerReference{
+						d.currentObject.AsOwner(),
+					},
+				},
+				Spec: api.ArangoMemberGroupSpec{
+					Group:         group,
+					DeploymentUID: d.currentObject.GetUID(),
+					Replicas:      groupSpec.GetCount(),
+				},
+			}
+
+			err := globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+				_, err := client.Create(ctxChild, obj, meta.CreateOptions{})
+				return err
+			})
+			if err != nil && !k8sutil.IsAlreadyExists(err) {
+				return errors.Wrapf(err, "Unable to create ArangoMemberGroup %s", name)
+			}
+
+			continue
+		}
+
+		if !d.isOwnerOf(amg) || amg.Spec.Group != group {
+			d.log.Str("name", name).Warn("ArangoMemberGroup is not owned by the deployment")
+			continue
+		}
+
+		observedGeneration := amg.GetGeneration()
+
+		if amg.IsSpecObserved() {
+			if amg.Spec.Replicas != groupSpec.GetCount() {
+				// Count of the group has been changed in the deployment
+				if err := patchArangoMemberGroup(ctx, cachedStatus, name, "spec", api.ArangoMemberGroupSpec{
+					Group:         amg.Spec.Group,
+					DeploymentUID: amg.Spec.DeploymentUID,
+					Replicas:      groupSpec.GetCount(),
+				}); err != nil {
+					return err
+				}
+
+				continue
+			}
+		} else if replicas := boundArangoMemberGroupReplicas(amg.Spec.Replicas, groupSpec); replicas != groupSpec.GetCount() {
+			if paused {
+				// Spec is not changed while reconciliation is paused, generation stays not observed until resume
+				observedGeneration = amg.Status.ObservedGeneration
+
+				if d.skippedMemberGroupScales[name] != amg.GetGeneration() {
+					d.skippedMemberGroupScales[name] = amg.GetGeneration()
+					d.log.Str("group", group.AsRole()).Int("from", groupSpec.GetCount()).Int("to", replicas).
+						Info("Scaling group with ArangoMemberGroup skipped, reconciliation is paused")
+					d.CreateEvent(k8sutil.NewGroupScaleSkippedEvent(d.currentObject, group.AsRole(), groupSpec.GetCount(), replicas, "ArangoMemberGroup"))
+				}
+			} else {
+				// Replicas have been changed by the user or the HorizontalPodAutoscaler
+				d.log.Str("group", group.AsRole()).Int("from", groupSpec.GetCount()).Int("to", replicas).
+					Info("Scaling group with ArangoMemberGroup")
+				p = append(p, patch.ItemReplace(patch.NewPath("spec", field, "count"), replicas))
+			}
+		}
+
+		newStatus := api.ArangoMemberGroupStatus{
+			Replicas:           len(status.Members.MembersOfGroup(group)),
+			Selector:           labels.SelectorFromSet(k8sutil.LabelsForDeployment(d.GetName(), group.AsRole())).String(),
+			ObservedGeneration: observedGeneration,
+		}
+
+		if newStatus != amg.Status {
+			statuses[name] = newStatus
+		}
+	}
+
+	// Deployment needs to be updated before the generation is marked as observed
+	if err := d.ApplyPatch(ctx, p...); err != nil {
+		return err
+	}
+
+	for name, status := range statuses {
+		if err := patchArangoMemberGroup(ctx, cachedStatus, name, "status", status); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// boundArangoMemberGroupReplicas returns replicas within minCount and maxCount of the group
+func boundArangoMemberGroupReplicas(replicas int, groupSpec api.ServerGroupSpec) int {
+	if min := groupSpec.GetMinCount(); replicas < min {
+		return min
+	}
+
+	if max := groupSpec.GetMaxCount(); replicas > max {
+		return max
+	}
+
+	return replicas
+}
+
+func patchArangoMemberGroup(ctx context.Context, cachedStatus inspectorInterface.Inspector, name, field string, obj interface{}) error {
+	data, err := json.Marshal(map[string]interface{}{
+		field: obj,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var subresources []string
+	if field == "status" {
+		subresources = append(subresources, "status")
+	}
+
+	err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := cachedStatus.ArangoMemberGroupsModInterface().V1().Patch(ctxChild, name, types.MergePatchType, data, meta.PatchOptions{}, subresources...)
+		return err
+	})
+	if err != nil && !k8sutil.IsNotFound(err) {
+		return errors.Wrapf(err, "Unable to update %s of ArangoMemberGroup %s", field, name)
+	}
+
+	return nil
+}
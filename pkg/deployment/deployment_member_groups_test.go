@@ -0,0 +1,244 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	recordfake "k8s.io/client-go/tools/record"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_BoundArangoMemberGroupReplicas(t *testing.T) {
+	spec := api.ServerGroupSpec{
+		MinCount: util.NewInt(3),
+		MaxCount: util.NewInt(5),
+	}
+
+	require.Equal(t, 3, boundArangoMemberGroupReplicas(0, spec))
+	require.Equal(t, 3, boundArangoMemberGroupReplicas(3, spec))
+	require.Equal(t, 4, boundArangoMemberGroupReplicas(4, spec))
+	require.Equal(t, 5, boundArangoMemberGroupReplicas(5, spec))
+	require.Equal(t, 5, boundArangoMemberGroupReplicas(10, spec))
+
+	require.Equal(t, 10, boundArangoMemberGroupReplicas(10, api.ServerGroupSpec{}))
+}
+
+func newArangoMemberGroupsTestDeployment(t *testing.T) *Deployment {
+	depl := &api.ArangoDeployment{
+		Spec: api.DeploymentSpec{
+			Mode: api.NewMode(api.DeploymentModeCluster),
+			Coordinators: api.ServerGroupSpec{
+				Count:    util.NewInt(3),
+				MinCount: util.NewInt(2),
+				MaxCount: util.NewInt(5),
+			},
+			DBServers: api.ServerGroupSpec{
+				Count: util.NewInt(3),
+			},
+		},
+	}
+
+	for _, id := range []string{"CRDN-1", "CRDN-2", "CRDN-3"} {
+		require.NoError(t, depl.Status.Members.Add(api.MemberStatus{ID: id}, api.ServerGroupCoordinators))
+	}
+
+	d, _ := createTestDeployment(t, Config{}, depl)
+	d.currentObject.UID = "deployment-uid"
+
+	_, err := d.deps.Client.Arango().DatabaseV1().ArangoDeployments(testNamespace).Create(context.Background(), d.currentObject, meta.CreateOptions{})
+	require.NoError(t, err)
+
+	return d
+}
+
+func inspectArangoMemberGroupsTest(t *testing.T, d *Deployment) {
+	require.NoError(t, d.acs.CurrentClusterCache().Refresh(context.Background()))
+	require.NoError(t, d.inspectArangoMemberGroups(context.Background(), d.GetCachedStatus()))
+}
+
+func getArangoMemberGroupTest(t *testing.T, d *Deployment, group api.ServerGroup) *api.ArangoMemberGroup {
+	amg, err := d.deps.Client.Arango().DatabaseV1().ArangoMemberGroups(testNamespace).
+		Get(context.Background(), api.ArangoMemberGroupName(testDeploymentName, group), meta.GetOptions{})
+	require.NoError(t, err)
+
+	return amg
+}
+
+// setArangoMemberGroupReplicasTest changes replicas of the group in the same way as the scale subresource does
+func setArangoMemberGroupReplicasTest(t *testing.T, d *Deployment, group api.ServerGroup, replicas int) {
+	amg := getArangoMemberGroupTest(t, d, group)
+	amg.Spec.Replicas = replicas
+	amg.Generation++
+
+	_, err := d.deps.Client.Arango().DatabaseV1().ArangoMemberGroups(testNamespace).Update(context.Background(), amg, meta.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func Test_InspectArangoMemberGroups(t *testing.T) {
+	t.Run("Create", func(t *testing.T) {
+		// Arrange
+		d := newArangoMemberGroupsTestDeployment(t)
+
+		// Act
+		inspectArangoMemberGroupsTest(t, d)
+
+		// Assert
+		for _, group := range []api.ServerGroup{api.ServerGroupCoordinators, api.ServerGroupDBServers} {
+			amg := getArangoMemberGroupTest(t, d, group)
+			require.Equal(t, group, amg.Spec.Group)
+			require.Equal(t, 3, amg.Spec.Replicas)
+			require.Equal(t, d.currentObject.GetUID(), amg.Spec.DeploymentUID)
+			require.True(t, d.isOwnerOf(amg))
+		}
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		// Arrange
+		d := newArangoMemberGroupsTestDeployment(t)
+		inspectArangoMemberGroupsTest(t, d)
+
+		// Act
+		inspectArangoMemberGroupsTest(t, d)
+
+		// Assert
+		amg := getArangoMemberGroupTest(t, d, api.ServerGroupCoordinators)
+		require.Equal(t, 3, amg.Status.Replicas)
+		require.Equal(t, "app=arangodb,arango_deployment=test,role=coordinator", amg.Status.Selector)
+		require.True(t, amg.IsSpecObserved())
+
+		amg = getArangoMemberGroupTest(t, d, api.ServerGroupDBServers)
+		require.Equal(t, 0, amg.Status.Replicas)
+		require.Equal(t, "app=arangodb,arango_deployment=test,role=dbserver", amg.Status.Selector)
+	})
+
+	t.Run("Replicas changed in the group", func(t *testing.T) {
+		// Arrange
+		d := newArangoMemberGroupsTestDeployment(t)
+		inspectArangoMemberGroupsTest(t, d)
+		inspectArangoMemberGroupsTest(t, d)
+
+		setArangoMemberGroupReplicasTest(t, d, api.ServerGroupCoordinators, 4)
+		require.False(t, getArangoMemberGroupTest(t, d, api.ServerGroupCoordinators).IsSpecObserved())
+
+		// Act
+		inspectArangoMemberGroupsTest(t, d)
+
+		// Assert
+		require.Equal(t, 4, d.currentObject.Spec.Coordinators.GetCount())
+		require.Equal(t, 3, d.currentObject.Spec.DBServers.GetCount())
+		require.True(t, getArangoMemberGroupTest(t, d, api.ServerGroupCoordinators).IsSpecObserved())
+	})
+
+	t.Run("Replicas bounded in the group", func(t *testing.T) {
+		// Arrange
+		d := newArangoMemberGroupsTestDeployment(t)
+		inspectArangoMemberGroupsTest(t, d)
+		inspectArangoMemberGroupsTest(t, d)
+
+		// Act
+		setArangoMemberGroupReplicasTest(t, d, api.ServerGroupCoordinators, 10)
+		inspectArangoMemberGroupsTest(t, d)
+
+		// Assert
+		require.Equal(t, 5, d.currentObject.Spec.Coordinators.GetCount())
+
+		// Act
+		setArangoMemberGroupReplicasTest(t, d, api.ServerGroupCoordinators, 1)
+		inspectArangoMemberGroupsTest(t, d)
+
+		// Assert
+		require.Equal(t, 2, d.currentObject.Spec.Coordinators.GetCount())
+	})
+
+	t.Run("Count changed in the deployment", func(t *testing.T) {
+		// Arrange
+		d := newArangoMemberGroupsTestDeployment(t)
+		inspectArangoMemberGroupsTest(t, d)
+		inspectArangoMemberGroupsTest(t, d)
+
+		require.NoError(t, d.ApplyPatch(context.Background(), patch.ItemReplace(patch.NewPath("spec", "dbservers", "count"), 5)))
+
+		// Act
+		inspectArangoMemberGroupsTest(t, d)
+
+		// Assert
+		require.Equal(t, 5, getArangoMemberGroupTest(t, d, api.ServerGroupDBServers).Spec.Replicas)
+		require.Equal(t, 5, d.currentObject.Spec.DBServers.GetCount())
+		require.Equal(t, 3, getArangoMemberGroupTest(t, d, api.ServerGroupCoordinators).Spec.Replicas)
+	})
+
+	t.Run("Replicas changed while paused", func(t *testing.T) {
+		// Arrange
+		d := newArangoMemberGroupsTestDeployment(t)
+		inspectArangoMemberGroupsTest(t, d)
+		inspectArangoMemberGroupsTest(t, d)
+
+		require.NoError(t, d.ApplyPatch(context.Background(), patch.ItemReplace(patch.NewPath("spec", "paused"), true)))
+		setArangoMemberGroupReplicasTest(t, d, api.ServerGroupCoordinators, 4)
+
+		// Act
+		inspectArangoMemberGroupsTest(t, d)
+		inspectArangoMemberGroupsTest(t, d)
+
+		// Assert
+		require.Equal(t, 3, d.currentObject.Spec.Coordinators.GetCount())
+		require.False(t, getArangoMemberGroupTest(t, d, api.ServerGroupCoordinators).IsSpecObserved())
+
+		events := d.deps.EventRecorder.(*recordfake.FakeRecorder).Events
+		require.Len(t, events, 1)
+		require.Contains(t, <-events, "Group Scale Skipped")
+
+		// Act
+		require.NoError(t, d.ApplyPatch(context.Background(), patch.ItemReplace(patch.NewPath("spec", "paused"), false)))
+		inspectArangoMemberGroupsTest(t, d)
+
+		// Assert
+		require.Equal(t, 4, d.currentObject.Spec.Coordinators.GetCount())
+		require.True(t, getArangoMemberGroupTest(t, d, api.ServerGroupCoordinators).IsSpecObserved())
+	})
+
+	t.Run("Not owned group", func(t *testing.T) {
+		// Arrange
+		d := newArangoMemberGroupsTestDeployment(t)
+		inspectArangoMemberGroupsTest(t, d)
+
+		amg := getArangoMemberGroupTest(t, d, api.ServerGroupCoordinators)
+		amg.OwnerReferences = nil
+		amg.Spec.Replicas = 4
+		amg.Generation++
+		_, err := d.deps.Client.Arango().DatabaseV1().ArangoMemberGroups(testNamespace).Update(context.Background(), amg, meta.UpdateOptions{})
+		require.NoError(t, err)
+
+		// Act
+		inspectArangoMemberGroupsTest(t, d)
+
+		// Assert
+		require.Equal(t, 3, d.currentObject.Spec.Coordinators.GetCount())
+		require.False(t, getArangoMemberGroupTest(t, d, api.ServerGroupCoordinators).IsSpecObserved())
+	})
+}
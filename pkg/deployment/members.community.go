@@ -29,7 +29,20 @@ import (
 )
 
 func (d *Deployment) createInitialTopology(ctx context.Context) error {
-	return nil
+	spec := d.GetSpec()
+
+	if !spec.Topology.IsEnabled() || spec.GetMode() == api.DeploymentModeSingle {
+		return nil
+	}
+
+	return d.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		if s.Topology != nil {
+			return false
+		}
+
+		s.Topology = api.NewTopologyStatus(spec.Topology)
+		return true
+	})
 }
 
 func (d *Deployment) renderMemberID(_ api.DeploymentSpec, status *api.DeploymentStatus, _ *api.ServerGroupStatus, group api.ServerGroup) string {
@@ -82,4 +82,9 @@ func (d *Deployment) CollectMetrics(m metrics.PushMetric) {
 	if r := d.resources; r != nil {
 		r.CollectMetrics(m)
 	}
+
+	// Autoscaler
+	if a := d.autoscaler; a != nil {
+		a.CollectMetrics(m)
+	}
 }
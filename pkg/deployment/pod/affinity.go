@@ -80,6 +80,22 @@ func AppendAffinityWithRole(p interfaces.PodCreator, a *core.PodAffinity, role s
 	}
 }
 
+// AppendTopologySpreadConstraints appends constraints to the pod spec.
+// Constraints without label selector get one which matches pods of the same deployment and role.
+func AppendTopologySpreadConstraints(p interfaces.PodCreator, s *core.PodSpec, constraints []core.TopologySpreadConstraint) {
+	for _, c := range constraints {
+		constraint := c.DeepCopy()
+
+		if constraint.LabelSelector == nil {
+			constraint.LabelSelector = &meta.LabelSelector{
+				MatchLabels: k8sutil.LabelsForDeployment(p.GetName(), p.GetRole()),
+			}
+		}
+
+		s.TopologySpreadConstraints = append(s.TopologySpreadConstraints, *constraint)
+	}
+}
+
 func MergePodAntiAffinity(a, b *core.PodAntiAffinity) {
 	if a == nil || b == nil {
 		return
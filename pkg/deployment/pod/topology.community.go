@@ -22,8 +22,11 @@
 package pod
 
 import (
+	"strconv"
+
 	core "k8s.io/api/core/v1"
 
+	topologyUtil "github.com/arangodb/kube-arangodb/pkg/deployment/topology"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/interfaces"
 )
@@ -37,7 +40,16 @@ func (t topology) Volumes(i Input) ([]core.Volume, []core.VolumeMount) {
 }
 
 func (t topology) Envs(i Input) []core.EnvVar {
-	return nil
+	if !topologyUtil.IsTopologyAwareGroup(i.Group) || !i.Status.Topology.IsTopologyOwned(i.Member.Topology) {
+		return nil
+	}
+
+	return []core.EnvVar{
+		{
+			Name:  topologyUtil.ArangoDBZone,
+			Value: strconv.Itoa(i.Member.Topology.Zone),
+		},
+	}
 }
 
 func (t topology) Verify(i Input, cachedStatus interfaces.Inspector) error {
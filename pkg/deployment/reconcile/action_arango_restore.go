@@ -0,0 +1,137 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func init() {
+	registerAction(api.ActionTypeArangoRestore, newArangoRestoreAction, backupRestoreTimeout)
+}
+
+const (
+	actionArangoRestoreLocalJobID api.PlanLocalKey = "jobID"
+)
+
+func newArangoRestoreAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionArangoRestore{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionArangoRestore implements an ArangoRestore.
+type actionArangoRestore struct {
+	// actionImpl implement timeout and member id functions
+	actionImpl
+}
+
+func (a actionArangoRestore) Start(ctx context.Context) (bool, error) {
+	name, ok := a.action.GetParam(restoreActionParam)
+	if !ok {
+		a.log.Error("Restore name is not set")
+		return true, nil
+	}
+
+	restore, err := a.actionCtx.GetRestore(ctx, name)
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			a.log.Str("restore", name).Warn("Restore is gone")
+			return true, nil
+		}
+
+		return false, errors.Wrapf(err, "Unable to get restore")
+	}
+
+	backupResource, err := a.actionCtx.GetBackup(ctx, restore.Spec.Backup.Name)
+	if err != nil {
+		a.log.Err(err).Error("Unable to find backup")
+		return true, a.finish(ctx, name, errors.Wrapf(err, "Unable to find backup"))
+	}
+
+	if backupResource.Status.Backup == nil {
+		a.log.Error("Backup ID is not set")
+		return true, a.finish(ctx, name, errors.Newf("Backup ID is not set"))
+	}
+
+	now := meta.Now()
+	restore.Status.Phase = backupApi.ArangoRestorePhaseRestoring
+	restore.Status.StartTime = &now
+	restore.Status.CompletionTime = nil
+	restore.Status.BackupID = backupResource.Status.Backup.ID
+	restore.Status.Message = ""
+
+	if err := a.actionCtx.UpdateRestoreStatus(ctx, restore); err != nil {
+		return false, err
+	}
+
+	return a.startBackupRestore(ctx, backupResource.Status.Backup.ID, actionArangoRestoreLocalJobID, a.finishFunc(name))
+}
+
+func (a actionArangoRestore) CheckProgress(ctx context.Context) (bool, bool, error) {
+	name, ok := a.action.GetParam(restoreActionParam)
+	if !ok {
+		return false, false, errors.Newf("Param is missing in action: %s", restoreActionParam)
+	}
+
+	return a.checkBackupRestoreProgress(ctx, actionArangoRestoreLocalJobID, a.finishFunc(name))
+}
+
+// finishFunc returns function saving the final phase of the restore
+func (a actionArangoRestore) finishFunc(name string) backupRestoreFinishFunc {
+	return func(ctx context.Context, restoreError error) error {
+		return a.finish(ctx, name, restoreError)
+	}
+}
+
+// finish saves the final phase of the restore
+func (a actionArangoRestore) finish(ctx context.Context, name string, restoreError error) error {
+	restore, err := a.actionCtx.GetRestore(ctx, name)
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	now := meta.Now()
+	restore.Status.CompletionTime = &now
+
+	if restoreError != nil {
+		restore.Status.Phase = backupApi.ArangoRestorePhaseFailed
+		restore.Status.Message = restoreError.Error()
+	} else {
+		restore.Status.Phase = backupApi.ArangoRestorePhaseRestored
+		restore.Status.Message = ""
+	}
+
+	return a.actionCtx.UpdateRestoreStatus(ctx, restore)
+}
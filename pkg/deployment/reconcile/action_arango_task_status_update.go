@@ -0,0 +1,123 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+const (
+	actionArangoTaskStatusUpdateState   = "state"
+	actionArangoTaskStatusUpdateMessage = "message"
+)
+
+func init() {
+	registerAction(api.ActionTypeArangoTaskStatusUpdate, newArangoTaskStatusUpdateAction, defaultTimeout)
+}
+
+func newArangoTaskStatusUpdateAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionArangoTaskStatusUpdate{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionArangoTaskStatusUpdate implements an ArangoTaskStatusUpdate.
+type actionArangoTaskStatusUpdate struct {
+	// actionImpl implement timeout and member id functions
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+// Start updates state of the ArangoTask referenced by the action.
+func (a *actionArangoTaskStatusUpdate) Start(ctx context.Context) (bool, error) {
+	state, ok := a.action.GetParam(actionArangoTaskStatusUpdateState)
+	if !ok {
+		a.log.Warn("State of the task is missing")
+		return true, nil
+	}
+
+	message, _ := a.action.GetParam(actionArangoTaskStatusUpdateMessage)
+
+	cache := a.actionCtx.ACS().CurrentClusterCache()
+
+	tasks, err := cache.ArangoTask().V1()
+	if err != nil {
+		return false, errors.Wrapf(err, "Unable to get ArangoTask inspector")
+	}
+
+	task, ok := getArangoTaskByUID(tasks.ListSimple(), a.action.TaskID)
+	if !ok {
+		a.log.Str("task", string(a.action.TaskID)).Warn("ArangoTask does not exist")
+		return true, nil
+	}
+
+	status := task.Status.DeepCopy()
+	status.State = api.ArangoTaskState(state)
+	status.Message = message
+	if status.AcceptedSpec == nil {
+		status.AcceptedSpec = task.Spec.DeepCopy()
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"status": status,
+	})
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := cache.ArangoTasksModInterface().V1().Patch(ctxChild, task.GetName(), types.MergePatchType, data, meta.PatchOptions{}, "status")
+		return err
+	})
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			a.log.Str("task", task.GetName()).Warn("ArangoTask does not exist")
+			return true, nil
+		}
+
+		return false, errors.Wrapf(err, "Unable to update ArangoTask %s", task.GetName())
+	}
+
+	return true, nil
+}
+
+// getArangoTaskByUID returns ArangoTask with the given UID
+func getArangoTaskByUID(tasks []*api.ArangoTask, uid types.UID) (*api.ArangoTask, bool) {
+	for _, task := range tasks {
+		if task.GetUID() == uid {
+			return task, true
+		}
+	}
+
+	return nil, false
+}
@@ -26,7 +26,6 @@ import (
 
 	"github.com/arangodb/go-driver"
 
-	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/util/arangod/conn"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
@@ -94,17 +93,59 @@ func (a actionBackupRestore) Start(ctx context.Context) (bool, error) {
 		return false, err
 	}
 
+	a.actionCtx.Add(actionBackupRestoreLocalBackupName, backupResource.GetName(), true)
+
+	return a.startBackupRestore(ctx, backupResource.Status.Backup.ID, actionBackupRestoreLocalJobID, a.finish(backupResource.GetName()))
+}
+
+func (a actionBackupRestore) CheckProgress(ctx context.Context) (bool, bool, error) {
+	backup, ok := a.actionCtx.Get(a.action, actionBackupRestoreLocalBackupName)
+	if !ok {
+		return false, false, errors.Newf("Local Key is missing in action: %s", actionBackupRestoreLocalBackupName)
+	}
+
+	return a.checkBackupRestoreProgress(ctx, actionBackupRestoreLocalJobID, a.finish(backup))
+}
+
+// finish returns function saving the result of the restore in the deployment status
+func (a actionBackupRestore) finish(backup string) backupRestoreFinishFunc {
+	return func(ctx context.Context, restoreError error) error {
+		return a.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+			result := &api.DeploymentRestoreResult{
+				RequestedFrom: backup,
+				State:         api.DeploymentRestoreStateRestored,
+			}
+
+			if restoreError != nil {
+				result.State = api.DeploymentRestoreStateRestoreFailed
+				result.Message = restoreError.Error()
+			}
+
+			s.Restore = result
+
+			return true
+		})
+	}
+}
+
+// backupRestoreFinishFunc saves the result of the restore
+type backupRestoreFinishFunc func(ctx context.Context, restoreError error) error
+
+// startBackupRestore starts restore of the backup with the given ID.
+// In the Cluster mode restore is executed asynchronously and ID of the job is saved in the local key of the action.
+// In other modes restore is executed synchronously and its result is passed to the finish function.
+func (a actionImpl) startBackupRestore(ctx context.Context, backupID string, jobKey api.PlanLocalKey, finish backupRestoreFinishFunc) (bool, error) {
 	switch mode := a.actionCtx.GetSpec().Mode.Get(); mode {
 	case api.DeploymentModeActiveFailover, api.DeploymentModeSingle:
-		return a.restoreSync(ctx, backupResource)
+		return a.restoreSync(ctx, backupID, finish)
 	case api.DeploymentModeCluster:
-		return a.restoreAsync(ctx, backupResource)
+		return a.restoreAsync(ctx, backupID, jobKey)
 	default:
 		return false, errors.Newf("Unknown mode %s", mode)
 	}
 }
 
-func (a actionBackupRestore) restoreAsync(ctx context.Context, backup *backupApi.ArangoBackup) (bool, error) {
+func (a actionImpl) restoreAsync(ctx context.Context, backupID string, jobKey api.PlanLocalKey) (bool, error) {
 	ctxChild, cancel := globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
 	defer cancel()
 
@@ -116,10 +157,9 @@ func (a actionBackupRestore) restoreAsync(ctx context.Context, backup *backupApi
 	ctxChild, cancel = globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
 	defer cancel()
 
-	if err := dbc.Backup().Restore(ctxChild, driver.BackupID(backup.Status.Backup.ID), nil); err != nil {
+	if err := dbc.Backup().Restore(ctxChild, driver.BackupID(backupID), nil); err != nil {
 		if id, ok := conn.IsAsyncJobInProgress(err); ok {
-			a.actionCtx.Add(actionBackupRestoreLocalJobID, id, true)
-			a.actionCtx.Add(actionBackupRestoreLocalBackupName, backup.GetName(), true)
+			a.actionCtx.Add(jobKey, id, true)
 
 			// Async request has been send
 			return false, nil
@@ -131,7 +171,7 @@ func (a actionBackupRestore) restoreAsync(ctx context.Context, backup *backupApi
 	return false, errors.Newf("Async response not received")
 }
 
-func (a actionBackupRestore) restoreSync(ctx context.Context, backup *backupApi.ArangoBackup) (bool, error) {
+func (a actionImpl) restoreSync(ctx context.Context, backupID string, finish backupRestoreFinishFunc) (bool, error) {
 	dbc, err := a.actionCtx.GetMembersState().State().GetDatabaseClient()
 	if err != nil {
 		a.log.Err(err).Debug("Failed to create database client")
@@ -139,27 +179,12 @@ func (a actionBackupRestore) restoreSync(ctx context.Context, backup *backupApi.
 	}
 
 	// The below action can take a while so the full parent timeout context is used.
-	restoreError := dbc.Backup().Restore(ctx, driver.BackupID(backup.Status.Backup.ID), nil)
+	restoreError := dbc.Backup().Restore(ctx, driver.BackupID(backupID), nil)
 	if restoreError != nil {
 		a.log.Err(restoreError).Error("Restore failed")
 	}
 
-	if err := a.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
-		result := &api.DeploymentRestoreResult{
-			RequestedFrom: backup.GetName(),
-		}
-
-		if restoreError != nil {
-			result.State = api.DeploymentRestoreStateRestoreFailed
-			result.Message = restoreError.Error()
-		} else {
-			result.State = api.DeploymentRestoreStateRestored
-		}
-
-		s.Restore = result
-
-		return true
-	}); err != nil {
+	if err := finish(ctx, restoreError); err != nil {
 		a.log.Err(err).Error("Unable to set restored state")
 		return false, err
 	}
@@ -167,15 +192,12 @@ func (a actionBackupRestore) restoreSync(ctx context.Context, backup *backupApi.
 	return true, nil
 }
 
-func (a actionBackupRestore) CheckProgress(ctx context.Context) (bool, bool, error) {
-	backup, ok := a.actionCtx.Get(a.action, actionBackupRestoreLocalBackupName)
-	if !ok {
-		return false, false, errors.Newf("Local Key is missing in action: %s", actionBackupRestoreLocalBackupName)
-	}
-
-	job, ok := a.actionCtx.Get(a.action, actionBackupRestoreLocalJobID)
+// checkBackupRestoreProgress checks the async restore job saved in the local key of the action.
+// Once the job is done, its result is passed to the finish function.
+func (a actionImpl) checkBackupRestoreProgress(ctx context.Context, jobKey api.PlanLocalKey, finish backupRestoreFinishFunc) (bool, bool, error) {
+	job, ok := a.actionCtx.Get(a.action, jobKey)
 	if !ok {
-		return false, false, errors.Newf("Local Key is missing in action: %s", actionBackupRestoreLocalJobID)
+		return false, false, errors.Newf("Local Key is missing in action: %s", jobKey)
 	}
 
 	ctxChild, cancel := globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
@@ -212,26 +234,13 @@ func (a actionBackupRestore) CheckProgress(ctx context.Context) (bool, bool, err
 				}
 			}
 		}
+
+		a.log.Err(restoreError).Error("Restore failed")
 	}
 
 	// Restore is done
 
-	if err := a.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
-		result := &api.DeploymentRestoreResult{
-			RequestedFrom: backup,
-			State:         api.DeploymentRestoreStateRestored,
-		}
-
-		if restoreError != nil {
-			a.log.Err(restoreError).Error("Restore failed")
-			result.State = api.DeploymentRestoreStateRestoreFailed
-			result.Message = restoreError.Error()
-		}
-
-		s.Restore = result
-
-		return true
-	}); err != nil {
+	if err := finish(ctx, restoreError); err != nil {
 		a.log.Err(err).Error("Unable to set restored state")
 		return false, false, err
 	}
@@ -22,6 +22,9 @@ package reconcile
 
 import (
 	"context"
+	"strconv"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	driver "github.com/arangodb/go-driver"
 
@@ -96,6 +99,7 @@ func (a *actionCleanoutMember) Start(ctx context.Context) (bool, error) {
 	// Update status
 	m.Phase = api.MemberPhaseCleanOut
 	m.CleanoutJobID = jobID
+	m.Drain = a.newDrainStatus()
 	if a.actionCtx.UpdateMember(ctx, m); err != nil {
 		return false, errors.WithStack(err)
 	}
@@ -131,15 +135,32 @@ func (a *actionCleanoutMember) CheckProgress(ctx context.Context) (bool, bool, e
 			// Revert cleanout state
 			m.Phase = api.MemberPhaseCreated
 			m.CleanoutJobID = ""
+			m.Drain = nil
 			if err := a.actionCtx.UpdateMember(ctx, m); err != nil {
 				return false, false, errors.WithStack(err)
 			}
 			return false, true, nil
 		}
+
+		if m.Drain != nil {
+			if remaining := cache.PlanServerShards()[agency.Server(m.ID)]; remaining != m.Drain.RemainingShards {
+				m.Drain = m.Drain.DeepCopy()
+				m.Drain.RemainingShards = remaining
+				if err := a.actionCtx.UpdateMember(ctx, m); err != nil {
+					return false, false, errors.WithStack(err)
+				}
+			}
+		}
 		return false, false, nil
 	}
 	// Cleanout completed
-	if m.Conditions.Update(api.ConditionTypeCleanedOut, true, "CleanedOut", "") {
+	drainUpdated := false
+	if m.Drain != nil && m.Drain.RemainingShards != 0 {
+		m.Drain = m.Drain.DeepCopy()
+		m.Drain.RemainingShards = 0
+		drainUpdated = true
+	}
+	if m.Conditions.Update(api.ConditionTypeCleanedOut, true, "CleanedOut", "") || drainUpdated {
 		if err := a.actionCtx.UpdateMember(ctx, m); err != nil {
 			return false, false, errors.WithStack(err)
 		}
@@ -148,3 +169,24 @@ func (a *actionCleanoutMember) CheckProgress(ctx context.Context) (bool, bool, e
 	// Cleanout completed
 	return true, false, nil
 }
+
+// newDrainStatus returns initial progress of the clean out
+func (a *actionCleanoutMember) newDrainStatus() *api.MemberDrainStatus {
+	drain := &api.MemberDrainStatus{
+		StartTime: meta.Now(),
+	}
+
+	if cache, ok := a.actionCtx.GetAgencyCache(); ok {
+		shards := cache.PlanServerShards()[agency.Server(a.action.MemberID)]
+		drain.InitialShards = shards
+		drain.RemainingShards = shards
+	}
+
+	if v, ok := a.action.GetParam(actionCleanOutMemberDataBytes); ok {
+		if b, err := strconv.ParseInt(v, 10, 64); err == nil {
+			drain.DataBytes = b
+		}
+	}
+
+	return drain
+}
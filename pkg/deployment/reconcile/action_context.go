@@ -51,6 +51,8 @@ type ActionContext interface {
 	reconciler.ArangoAgencyGet
 	reconciler.DeploymentInfoGetter
 	reconciler.DeploymentDatabaseClient
+	reconciler.ArangoRestoreContext
+	reconciler.KubernetesEventGenerator
 
 	member.StateInspectorGetter
 
@@ -255,6 +257,18 @@ func (ac *actionContext) GetBackup(ctx context.Context, backup string) (*backupA
 	return ac.context.GetBackup(ctx, backup)
 }
 
+func (ac *actionContext) GetRestore(ctx context.Context, restore string) (*backupApi.ArangoRestore, error) {
+	return ac.context.GetRestore(ctx, restore)
+}
+
+func (ac *actionContext) ListRestores(ctx context.Context) ([]backupApi.ArangoRestore, error) {
+	return ac.context.ListRestores(ctx)
+}
+
+func (ac *actionContext) UpdateRestoreStatus(ctx context.Context, restore *backupApi.ArangoRestore) error {
+	return ac.context.UpdateRestoreStatus(ctx, restore)
+}
+
 func (ac *actionContext) WithStatusUpdateErr(ctx context.Context, action reconciler.DeploymentStatusUpdateErrFunc) error {
 	return ac.context.WithStatusUpdateErr(ctx, action)
 }
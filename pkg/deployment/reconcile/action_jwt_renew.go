@@ -0,0 +1,108 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func init() {
+	registerAction(api.ActionTypeJWTRenew, newJWTRenew, defaultTimeout)
+}
+
+func newJWTRenew(action api.Action, actionCtx ActionContext) Action {
+	a := &jwtRenewAction{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+type jwtRenewAction struct {
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+// Start replaces token in the JWT secret with the new random one. Rotation of the token is done by the JWT plan.
+func (a *jwtRenewAction) Start(ctx context.Context) (bool, error) {
+	folder, err := ensureJWTFolderSupportFromAction(a.actionCtx)
+	if err != nil {
+		return false, errors.Wrapf(err, "Action not supported")
+	}
+
+	if !folder {
+		return false, errors.Newf("JWT rotation is not supported")
+	}
+
+	secretName := a.actionCtx.GetSpec().Authentication.GetJWTSecretName()
+
+	s, ok := a.actionCtx.ACS().CurrentClusterCache().Secret().V1().GetSimple(secretName)
+	if !ok {
+		return false, errors.Newf("JWT Secret %s is missing", secretName)
+	}
+
+	if !k8sutil.IsOwner(a.actionCtx.GetAPIObject().AsOwner(), s) {
+		return false, errors.Newf("JWT Secret %s is not owned by the deployment", secretName)
+	}
+
+	tokenData := make([]byte, 32)
+	if _, err := rand.Read(tokenData); err != nil {
+		return false, errors.Wrapf(err, "Unable to generate token")
+	}
+
+	token := hex.EncodeToString(tokenData)
+
+	p := patch.NewPatch()
+	if _, ok := s.Data[constants.SecretKeyToken]; ok {
+		p.ItemReplace(patch.NewPath("data", constants.SecretKeyToken), base64.StdEncoding.EncodeToString([]byte(token)))
+	} else {
+		p.ItemAdd(patch.NewPath("data", constants.SecretKeyToken), base64.StdEncoding.EncodeToString([]byte(token)))
+	}
+
+	data, err := p.Marshal()
+	if err != nil {
+		return false, errors.Wrapf(err, "Unable to encrypt patch")
+	}
+
+	err = globals.GetGlobalTimeouts().Kubernetes().RunWithTimeout(ctx, func(ctxChild context.Context) error {
+		_, err := a.actionCtx.ACS().CurrentClusterCache().SecretsModInterface().V1().Patch(ctxChild, secretName, types.JSONPatchType, data, meta.PatchOptions{})
+		return err
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "Unable to update secret: %s", secretName)
+	}
+
+	return true, nil
+}
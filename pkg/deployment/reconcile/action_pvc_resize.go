@@ -24,14 +24,21 @@ import (
 	"context"
 
 	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/globals"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
+const (
+	// actionPVCResizeSize defines size to which PVC needs to be grown, overrides size requested in the spec if greater
+	actionPVCResizeSize = "size"
+)
+
 func init() {
 	registerAction(api.ActionTypePVCResize, newPVCResizeAction, pvcResizeTimeout)
 }
@@ -86,11 +93,33 @@ func (a *actionPVCResize) Start(ctx context.Context) (bool, error) {
 		res = groupSpec.Resources.Requests
 	}
 
-	if requestedSize, ok := res[core.ResourceStorage]; ok {
+	requestedSize, ok := res[core.ResourceStorage]
+
+	autoGrown := false
+	if size, exists := a.action.GetParam(actionPVCResizeSize); exists {
+		grownSize, err := resource.ParseQuantity(size)
+		if err != nil {
+			a.log.Err(err).Str("size", size).Error("Unable to parse requested size")
+			return true, nil
+		}
+
+		if !ok || grownSize.Cmp(requestedSize) > 0 {
+			requestedSize, ok, autoGrown = grownSize, true, true
+		}
+	}
+
+	if ok {
 		if volumeSize, ok := pvc.Spec.Resources.Requests[core.ResourceStorage]; ok {
 			cmp := volumeSize.Cmp(requestedSize)
 			if cmp < 0 {
 				pvc.Spec.Resources.Requests[core.ResourceStorage] = requestedSize
+				if autoGrown {
+					// Size reached by autogrow is kept on the PVC, so it is never considered as a shrink
+					if pvc.Annotations == nil {
+						pvc.Annotations = map[string]string{}
+					}
+					pvc.Annotations[deployment.ArangoDeploymentPVCAutoGrownAnnotation] = requestedSize.String()
+				}
 				nctx, c := globals.GetGlobals().Timeouts().Kubernetes().WithTimeout(ctx)
 				defer c()
 
@@ -98,6 +127,10 @@ func (a *actionPVCResize) Start(ctx context.Context) (bool, error) {
 					return false, err
 				}
 
+				if autoGrown {
+					a.actionCtx.CreateEvent(k8sutil.NewPVCAutoGrownEvent(a.actionCtx.GetAPIObject(), pvc.GetName(), volumeSize.String(), requestedSize.String()))
+				}
+
 				return false, nil
 			}
 		}
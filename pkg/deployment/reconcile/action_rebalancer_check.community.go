@@ -0,0 +1,82 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//go:build !enterprise
+// +build !enterprise
+
+package reconcile
+
+import (
+	"context"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+func init() {
+	registerAction(api.ActionTypeRebalancerCheck, newRebalancerCheckAction, defaultTimeout)
+}
+
+func newRebalancerCheckAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionRebalancerCheck{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionRebalancerCheck removes finished move shard jobs from the rebalancer status.
+type actionRebalancerCheck struct {
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+// Start checks the state of the move shard jobs in the agency.
+func (a *actionRebalancerCheck) Start(ctx context.Context) (bool, error) {
+	status := a.actionCtx.GetStatus()
+	if !status.Rebalancer.IsMoveInProgress() {
+		return true, nil
+	}
+
+	cache, ok := a.actionCtx.GetAgencyCache()
+	if !ok {
+		return false, errors.Newf("AgencyCache is not ready")
+	}
+
+	remaining, succeeded, failed := rebalancerCheckJobs(status.Rebalancer, cache, time.Now())
+
+	if err := a.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		if s.Rebalancer == nil {
+			return false
+		}
+
+		s.Rebalancer.MoveJobs = remaining
+		return true
+	}); err != nil {
+		return false, err
+	}
+
+	metrics := a.actionCtx.Metrics().GetRebalancer()
+	metrics.AddSuccesses(succeeded)
+	metrics.AddFailures(failed)
+	metrics.SetCurrent(len(remaining))
+
+	return true, nil
+}
@@ -0,0 +1,65 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//go:build !enterprise
+// +build !enterprise
+
+package reconcile
+
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+func init() {
+	registerAction(api.ActionTypeRebalancerClean, newRebalancerCleanAction, defaultTimeout)
+}
+
+func newRebalancerCleanAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionRebalancerClean{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionRebalancerClean removes rebalancer status once rebalancer is disabled.
+type actionRebalancerClean struct {
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+// Start removes rebalancer status. Move shard jobs which are already started are not cancelled.
+func (a *actionRebalancerClean) Start(ctx context.Context) (bool, error) {
+	if err := a.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		if s.Rebalancer == nil {
+			return false
+		}
+
+		s.Rebalancer = nil
+		return true
+	}); err != nil {
+		return false, err
+	}
+
+	a.actionCtx.Metrics().GetRebalancer().SetCurrent(0)
+
+	return true, nil
+}
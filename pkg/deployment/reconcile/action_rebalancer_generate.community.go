@@ -0,0 +1,147 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//go:build !enterprise
+// +build !enterprise
+
+package reconcile
+
+import (
+	"context"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/go-driver"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+)
+
+func init() {
+	registerAction(api.ActionTypeRebalancerGenerate, newRebalancerGenerateAction, defaultTimeout)
+}
+
+func newRebalancerGenerateAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionRebalancerGenerate{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionRebalancerGenerate starts move shard jobs which balance shards across DBServers.
+type actionRebalancerGenerate struct {
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+// Start generates moves from the current agency state and creates move shard jobs for them.
+func (a *actionRebalancerGenerate) Start(ctx context.Context) (bool, error) {
+	spec := a.actionCtx.GetSpec()
+	status := a.actionCtx.GetStatus()
+
+	if !spec.Rebalancer.IsEnabled() || status.Rebalancer.IsMoveInProgress() {
+		return true, nil
+	}
+
+	cache, ok := a.actionCtx.GetAgencyCache()
+	if !ok {
+		return false, errors.Newf("AgencyCache is not ready")
+	}
+
+	moves := rebalancerPlanMoves(spec, status, cache, a.actionCtx.ACS())
+	if len(moves) == 0 {
+		return true, nil
+	}
+
+	c, err := a.actionCtx.GetMembersState().State().GetDatabaseClient()
+	if err != nil {
+		return false, errors.Wrapf(err, "Unable to create database client")
+	}
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
+	defer cancel()
+	cluster, err := c.Cluster(ctxChild)
+	if err != nil {
+		return false, errors.Wrapf(err, "Unable to access cluster")
+	}
+
+	jobs := make([]string, 0, len(moves))
+
+	for _, move := range moves {
+		jobID, err := a.move(ctx, c, cluster, move)
+		if err != nil {
+			a.log.Err(err).Str("database", move.Database).Str("collection", move.CollectionName).Str("shard", move.Shard).
+				Warn("Unable to move shard")
+			continue
+		}
+
+		a.log.Str("job-id", jobID).Str("shard", move.Shard).Str("from", string(move.From)).Str("to", string(move.To)).
+			Bool("leader", move.Leader).Debug("Move shard job started")
+
+		jobs = append(jobs, jobID)
+	}
+
+	if len(jobs) == 0 {
+		return false, errors.Newf("Unable to start any move shard job")
+	}
+
+	now := meta.Now()
+
+	if err := a.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		s.Rebalancer = &api.ArangoDeploymentRebalancerStatus{
+			LastCheckTime: &now,
+			MoveJobs:      jobs,
+		}
+		return true
+	}); err != nil {
+		return false, err
+	}
+
+	metrics := a.actionCtx.Metrics().GetRebalancer()
+	metrics.AddMoves(len(jobs))
+	metrics.AddFailures(len(moves) - len(jobs))
+	metrics.SetCurrent(len(jobs))
+
+	return true, nil
+}
+
+func (a *actionRebalancerGenerate) move(ctx context.Context, c driver.Client, cluster driver.Cluster, move rebalancerMove) (string, error) {
+	ctxChild, cancel := globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
+	defer cancel()
+
+	db, err := c.Database(ctxChild, move.Database)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	col, err := db.Collection(ctxChild, move.CollectionName)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	var jobID string
+	if err := cluster.MoveShard(driver.WithJobIDResponse(ctxChild, &jobID), col, driver.ShardID(move.Shard),
+		driver.ServerID(move.From), driver.ServerID(move.To)); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return jobID, nil
+}
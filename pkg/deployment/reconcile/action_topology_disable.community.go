@@ -21,6 +21,42 @@
 
 package reconcile
 
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
 type topologyDisable struct {
-	actionEmpty
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+// Start removes topology zones and member assignments
+func (a *topologyDisable) Start(ctx context.Context) (bool, error) {
+	if err := a.actionCtx.WithStatusUpdateErr(ctx, func(s *api.DeploymentStatus) (bool, error) {
+		if s.Topology == nil {
+			return false, nil
+		}
+
+		s.Topology = nil
+
+		for _, e := range s.Members.AsList() {
+			if e.Member.Topology == nil {
+				continue
+			}
+
+			e.Member.Topology = nil
+			if err := s.Members.Update(e.Member, e.Group); err != nil {
+				return false, err
+			}
+		}
+
+		return true, nil
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
@@ -21,6 +21,36 @@
 
 package reconcile
 
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
 type topologyEnable struct {
-	actionEmpty
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+// Start creates topology zones from the spec
+func (a *topologyEnable) Start(ctx context.Context) (bool, error) {
+	spec := a.actionCtx.GetSpec()
+
+	if !spec.Topology.IsEnabled() {
+		return true, nil
+	}
+
+	if err := a.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		if s.Topology != nil {
+			return false
+		}
+
+		s.Topology = api.NewTopologyStatus(spec.Topology)
+		return true
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
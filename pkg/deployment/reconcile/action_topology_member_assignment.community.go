@@ -21,6 +21,124 @@
 
 package reconcile
 
+import (
+	"context"
+	"strconv"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
 type topologyMemberAssignment struct {
-	actionEmpty
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+// Start assigns member to the zone. Member which is already running is assigned to the zone of its node.
+func (a *topologyMemberAssignment) Start(ctx context.Context) (bool, error) {
+	requestedZone := -1
+
+	if v, ok := a.action.Params[topologyZoneKey]; ok {
+		z, err := strconv.Atoi(v)
+		if err != nil {
+			return false, errors.Wrapf(err, "Invalid zone %s", v)
+		}
+		requestedZone = z
+	}
+
+	memberLabel := a.getMemberLabel()
+
+	if err := a.actionCtx.WithStatusUpdateErr(ctx, func(s *api.DeploymentStatus) (bool, error) {
+		t := s.Topology
+		if !t.Enabled() {
+			return false, nil
+		}
+
+		m, g, ok := s.Members.ElementByID(a.action.MemberID)
+		if !ok || g != a.action.Group {
+			return false, nil
+		}
+
+		zone, label := requestedZone, memberLabel
+
+		if zone < 0 {
+			if t.IsTopologyOwned(m.Topology) {
+				return false, nil
+			}
+
+			zone = getTopologyZoneForMember(t, g, label)
+		} else {
+			// Member will be moved to the other physical zone
+			label = ""
+		}
+
+		if zone < 0 || zone >= len(t.Zones) {
+			return false, errors.Newf("Zone %d does not exist", zone)
+		}
+
+		t.RemoveMember(g, m.ID)
+		t.Zones[zone].AddMember(g, m.ID)
+
+		if label != "" {
+			t.RegisterTopologyLabel(zone, label)
+		}
+
+		m.Topology = &api.TopologyMemberStatus{
+			ID:    t.ID,
+			Zone:  zone,
+			Label: label,
+		}
+
+		if err := s.Members.Update(m, g); err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// getMemberLabel returns value of the topology label of the node on which member is running
+func (a *topologyMemberAssignment) getMemberLabel() string {
+	status := a.actionCtx.GetStatus()
+
+	m, _, ok := status.Members.ElementByID(a.action.MemberID)
+	if !ok || status.Topology == nil {
+		return ""
+	}
+
+	return getTopologyMemberNodeLabel(a.actionCtx, m, status.Topology.Label)
+}
+
+// getTopologyMemberNodeLabel returns value of the label of the node on which member is running
+func getTopologyMemberNodeLabel(actionCtx ActionContext, m api.MemberStatus, label string) string {
+	if m.Pod.GetName() == "" {
+		return ""
+	}
+
+	cache, ok := actionCtx.ACS().ClusterCache(m.ClusterID)
+	if !ok {
+		return ""
+	}
+
+	pod, ok := cache.Pod().V1().GetSimple(m.Pod.GetName())
+	if !ok || pod.Spec.NodeName == "" {
+		return ""
+	}
+
+	nodes, err := cache.Node().V1()
+	if err != nil {
+		return ""
+	}
+
+	node, ok := nodes.GetSimple(pod.Spec.NodeName)
+	if !ok {
+		return ""
+	}
+
+	return node.Labels[label]
 }
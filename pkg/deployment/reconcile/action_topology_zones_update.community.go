@@ -21,6 +21,46 @@
 
 package reconcile
 
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
 type topologyZonesUpdate struct {
-	actionEmpty
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+// Start resizes zones to match the spec and binds zones to the physical zones (topology label values) of the members
+func (a *topologyZonesUpdate) Start(ctx context.Context) (bool, error) {
+	spec := a.actionCtx.GetSpec()
+
+	if err := a.actionCtx.WithStatusUpdateErr(ctx, func(s *api.DeploymentStatus) (bool, error) {
+		if !s.Topology.Enabled() {
+			return false, nil
+		}
+
+		changed, err := resizeTopologyZones(s, spec.Topology.GetZones(), spec.Topology.GetLabel(), func(m api.MemberStatus) string {
+			return getTopologyMemberNodeLabel(a.actionCtx, m, spec.Topology.GetLabel())
+		})
+		if err != nil {
+			return false, err
+		}
+
+		for _, e := range s.Members.AsList() {
+			if m := e.Member.Topology; s.Topology.IsTopologyOwned(m) && m.Label != "" {
+				if s.Topology.RegisterTopologyLabel(m.Zone, m.Label) {
+					changed = true
+				}
+			}
+		}
+
+		return changed, nil
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
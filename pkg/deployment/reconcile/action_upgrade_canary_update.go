@@ -0,0 +1,96 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+const (
+	actionUpgradeCanaryUpdatePhase   = "phase"
+	actionUpgradeCanaryUpdateMessage = "message"
+)
+
+func init() {
+	registerAction(api.ActionTypeUpgradeCanaryUpdate, newUpgradeCanaryUpdateAction, defaultTimeout)
+}
+
+func newUpgradeCanaryUpdateAction(action api.Action, actionCtx ActionContext) Action {
+	a := &actionUpgradeCanaryUpdate{}
+
+	a.actionImpl = newActionImplDefRef(action, actionCtx)
+
+	return a
+}
+
+// actionUpgradeCanaryUpdate implements an UpgradeCanaryUpdate.
+type actionUpgradeCanaryUpdate struct {
+	// actionImpl implement timeout and member id functions
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+// Start updates phase of the canary upgrade. Upgrading phase starts new canary and keeps information about current image.
+func (a *actionUpgradeCanaryUpdate) Start(ctx context.Context) (bool, error) {
+	phase, ok := a.action.GetParam(actionUpgradeCanaryUpdatePhase)
+	if !ok {
+		a.log.Warn("Phase of the canary upgrade is missing")
+		return true, nil
+	}
+
+	message, _ := a.action.GetParam(actionUpgradeCanaryUpdateMessage)
+
+	if err := a.actionCtx.WithStatusUpdate(ctx, func(s *api.DeploymentStatus) bool {
+		if p := api.DeploymentUpgradeCanaryPhase(phase); p == api.DeploymentUpgradeCanaryPhaseUpgrading {
+			s.UpgradeCanary = &api.DeploymentUpgradeCanaryStatus{
+				Image:              a.action.Image,
+				FromImage:          s.CurrentImage.DeepCopy(),
+				Phase:              p,
+				Message:            message,
+				LastTransitionTime: meta.Now(),
+			}
+		} else {
+			if s.UpgradeCanary == nil || s.UpgradeCanary.Image != a.action.Image {
+				a.log.Str("image", a.action.Image).Warn("Canary upgrade is not started for the image")
+				return false
+			}
+
+			if s.UpgradeCanary.Phase == p && s.UpgradeCanary.Message == message {
+				return false
+			}
+
+			s.UpgradeCanary.Phase = p
+			s.UpgradeCanary.Message = message
+			s.UpgradeCanary.LastTransitionTime = meta.Now()
+		}
+
+		return true
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -224,7 +224,7 @@ func (r *Reconciler) isVolumeSizeChanged(_ context.Context, _ k8sutil.APIObject,
 }
 
 // shouldVolumeResize returns false when a volume should not resize.
-// Currently, it is only possible to shrink a volume size.
+// Currently, it is only possible to shrink a volume size. Volumes grown automatically are not shrunk.
 // When return true then the actual and required volume size are returned.
 func shouldVolumeResize(groupSpec api.ServerGroupSpec,
 	pvc *core.PersistentVolumeClaim) (bool, resource.Quantity, resource.Quantity) {
@@ -238,6 +238,11 @@ func shouldVolumeResize(groupSpec api.ServerGroupSpec,
 	if requestedSize, ok := res[core.ResourceStorage]; ok {
 		if volumeSize, ok := pvc.Spec.Resources.Requests[core.ResourceStorage]; ok {
 			if volumeSize.Cmp(requestedSize) > 0 {
+				if isVolumeAutoGrown(pvc, volumeSize) {
+					// The volume has been grown automatically, it should not be shrunk.
+					return false, resource.Quantity{}, resource.Quantity{}
+				}
+
 				// The actual PVC's volume size is greater than requested size, so it can be shrunk to the requested size.
 				return true, volumeSize, requestedSize
 			}
@@ -247,6 +252,22 @@ func shouldVolumeResize(groupSpec api.ServerGroupSpec,
 	return false, resource.Quantity{}, resource.Quantity{}
 }
 
+// isVolumeAutoGrown returns true when the volume size does not exceed the size reached by autogrow.
+// It does not depend on the autogrow spec, so the volume is not shrunk after autogrow is disabled or maxSize is lowered.
+func isVolumeAutoGrown(pvc *core.PersistentVolumeClaim, volumeSize resource.Quantity) bool {
+	size, ok := pvc.GetAnnotations()[deployment.ArangoDeploymentPVCAutoGrownAnnotation]
+	if !ok {
+		return false
+	}
+
+	grownSize, err := resource.ParseQuantity(size)
+	if err != nil {
+		return false
+	}
+
+	return volumeSize.Cmp(grownSize) <= 0
+}
+
 func getRequiredReplaceMessage(podName string) string {
 	return fmt.Sprintf("%s annotation is required to be set on the pod %s",
 		deployment.ArangoDeploymentPodReplaceAnnotation, podName)
@@ -45,6 +45,7 @@ type Context interface {
 	reconciler.DeploymentInfoGetter
 	reconciler.DeploymentDatabaseClient
 	reconciler.KubernetesEventGenerator
+	reconciler.ArangoRestoreContext
 
 	member.StateInspectorGetter
 
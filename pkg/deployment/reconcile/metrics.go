@@ -27,6 +27,8 @@ import (
 
 type Metrics struct {
 	Rebalancer MetricsRebalancer
+
+	Shards MetricsShards
 }
 
 func (m *Metrics) GetRebalancer() *MetricsRebalancer {
@@ -37,6 +39,14 @@ func (m *Metrics) GetRebalancer() *MetricsRebalancer {
 	return &m.Rebalancer
 }
 
+func (m *Metrics) GetShards() *MetricsShards {
+	if m == nil {
+		return nil
+	}
+
+	return &m.Shards
+}
+
 type MetricsRebalancer struct {
 	enabled bool
 	moves   int
@@ -80,6 +90,20 @@ func (m *MetricsRebalancer) AddSuccesses(i int) {
 	m.succeeded += i
 }
 
+type MetricsShards struct {
+	zonesKnown     bool
+	zoneViolations int
+}
+
+// SetZoneViolations sets number of shards which are not spread across zones, negative value means that zones are unknown
+func (m *MetricsShards) SetZoneViolations(violations int) {
+	if m == nil {
+		return
+	}
+	m.zonesKnown = violations >= 0
+	m.zoneViolations = violations
+}
+
 func (r *Reconciler) CollectMetrics(m metrics.PushMetric) {
 	if r.metrics.Rebalancer.enabled {
 		m.Push(metric_descriptions.ArangodbOperatorRebalancerEnabledGauge(1, r.namespace, r.name))
@@ -90,4 +114,8 @@ func (r *Reconciler) CollectMetrics(m metrics.PushMetric) {
 	} else {
 		m.Push(metric_descriptions.ArangodbOperatorRebalancerEnabledGauge(0, r.namespace, r.name))
 	}
+
+	if r.metrics.Shards.zonesKnown {
+		m.Push(metric_descriptions.ArangodbOperatorShardsZoneViolationsGauge(float64(r.metrics.Shards.zoneViolations), r.namespace, r.name))
+	}
 }
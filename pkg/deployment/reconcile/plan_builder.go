@@ -40,6 +40,12 @@ const (
 // CreatePlan considers the current specification & status of the deployment creates a plan to
 // get the status in line with the specification.
 // If a plan already exists, nothing is done.
+// No plan is created while reconciliation of the deployment is paused.
 func (d *Reconciler) CreatePlan(ctx context.Context) (error, bool) {
+	if d.isPaused() {
+		d.planLogger.Debug("Reconciliation is paused, skipping plan creation")
+		return nil, false
+	}
+
 	return d.generatePlan(ctx, d.generatePlanFunc(d.createHighPlan, plannerHigh{}), d.generatePlanFunc(d.createResourcesPlan, plannerResources{}), d.generatePlanFunc(d.createNormalPlan, plannerNormal{}))
 }
@@ -40,6 +40,7 @@ type PlanBuilderContext interface {
 	reconciler.ArangoAgencyGet
 	reconciler.DeploymentDatabaseClient
 	reconciler.KubernetesEventGenerator
+	reconciler.ArangoRestoreContext
 
 	member.StateInspectorGetter
 
@@ -58,11 +58,14 @@ func (r *Reconciler) createHighPlan(ctx context.Context, apiObject k8sutil.APIOb
 		ApplyIfEmpty(r.createTopologyMemberConditionPlan).
 		ApplyIfEmpty(r.createRebalancerCheckPlan).
 		ApplyIfEmpty(r.createMemberFailedRestoreHighPlan).
+		ApplyIfEmpty(r.createArangoTaskStatusPlan).
 		ApplyWithBackOff(BackOffCheck, time.Minute, r.emptyPlanBuilder)).
 		ApplyIfEmptyWithBackOff(TimezoneCheck, time.Minute, r.createTimezoneUpdatePlan).
-		Apply(r.createBackupInProgressConditionPlan). // Discover backups always
-		Apply(r.createMaintenanceConditionPlan).      // Discover maintenance always
-		Apply(r.cleanupConditions)                    // Cleanup Conditions
+		Apply(r.createBackupInProgressConditionPlan).  // Discover backups always
+		Apply(r.createMaintenanceConditionPlan).       // Discover maintenance always
+		Apply(r.createMaintenanceWindowConditionPlan). // Discover maintenance window always
+		Apply(r.createShardZonesConditionPlan).        // Discover shard zones always
+		Apply(r.cleanupConditions)                     // Cleanup Conditions
 
 	return q.Plan(), q.BackOff(), true
 }
@@ -0,0 +1,114 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// maintenanceWindowActions contains actions which restart or shut down members.
+// Plans with such actions are deferred until the maintenance window is open.
+var maintenanceWindowActions = map[api.ActionType]bool{
+	api.ActionTypeRotateMember:   true,
+	api.ActionTypeUpgradeMember:  true,
+	api.ActionTypeShutdownMember: true,
+	api.ActionTypeRecreateMember: true,
+}
+
+// getMaintenanceWindowTime returns current time in the deployment timezone
+func getMaintenanceWindowTime(spec api.DeploymentSpec) time.Time {
+	now := time.Now()
+
+	if tz, ok := GetTimezone(spec.Timezone); ok {
+		if data, ok := tz.GetData(); ok {
+			if loc, err := time.LoadLocationFromTZData(tz.Name, data); err == nil {
+				return now.In(loc)
+			}
+		}
+	}
+
+	return now.UTC()
+}
+
+// withMaintenanceWindow defers the plan created by the builder when it contains actions which restart or shut down members
+// and the maintenance window is closed. Builders responsible for recovery of failed members should not be wrapped.
+func (r *Reconciler) withMaintenanceWindow(pb planBuilder) planBuilder {
+	return func(ctx context.Context, apiObject k8sutil.APIObject,
+		spec api.DeploymentSpec, status api.DeploymentStatus,
+		context PlanBuilderContext) api.Plan {
+		plan := pb(ctx, apiObject, spec, status, context)
+
+		deferred := plan.Filter(func(a api.Action) bool {
+			return maintenanceWindowActions[a.Type]
+		})
+
+		if len(deferred) == 0 {
+			return plan
+		}
+
+		now := getMaintenanceWindowTime(spec)
+
+		if spec.MaintenanceWindows.IsOpen(now) {
+			return plan
+		}
+
+		r.planLogger.
+			Str("action", deferred[0].Type.String()).
+			Str("role", deferred[0].Group.AsRole()).
+			Str("member", deferred[0].MemberID).
+			Info("Action deferred until the next maintenance window")
+
+		if status.Conditions.IsTrue(api.ConditionTypePendingMaintenanceWindow) {
+			return nil
+		}
+
+		message := fmt.Sprintf("%s of %s %s is waiting for the maintenance window", deferred[0].Type, deferred[0].Group.AsRole(), deferred[0].MemberID)
+		if next, ok := spec.MaintenanceWindows.Next(now); ok {
+			message = fmt.Sprintf("%s, next window starts at %s", message, next.Format(time.RFC3339))
+		}
+
+		return api.Plan{
+			updateConditionActionV2("Maintenance window is closed", api.ConditionTypePendingMaintenanceWindow, true, "Maintenance window is closed", message, ""),
+		}
+	}
+}
+
+// createMaintenanceWindowConditionPlan removes PendingMaintenanceWindow condition once the maintenance window is open
+func (r *Reconciler) createMaintenanceWindowConditionPlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	if _, ok := status.Conditions.Get(api.ConditionTypePendingMaintenanceWindow); !ok {
+		return nil
+	}
+
+	if !spec.MaintenanceWindows.IsOpen(getMaintenanceWindowTime(spec)) {
+		return nil
+	}
+
+	return api.Plan{
+		removeConditionActionV2("Maintenance window is open", api.ConditionTypePendingMaintenanceWindow),
+	}
+}
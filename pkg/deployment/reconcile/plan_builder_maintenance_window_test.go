@@ -0,0 +1,127 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func Test_MaintenanceWindow(t *testing.T) {
+	rotate := func(ctx context.Context, apiObject k8sutil.APIObject,
+		spec api.DeploymentSpec, status api.DeploymentStatus,
+		context PlanBuilderContext) api.Plan {
+		return api.Plan{
+			actions.NewAction(api.ActionTypeKillMemberPod, api.ServerGroupDBServers, withPredefinedMember("id")),
+			actions.NewAction(api.ActionTypeRotateMember, api.ServerGroupDBServers, withPredefinedMember("id")),
+		}
+	}
+
+	closed := api.MaintenanceWindows{
+		{
+			Schedule: "0 0 1 1 *",
+			Duration: meta.Duration{Duration: time.Second},
+		},
+	}
+
+	open := api.MaintenanceWindows{
+		{
+			Schedule: "* * * * *",
+			Duration: meta.Duration{Duration: 2 * time.Minute},
+		},
+	}
+
+	r := newTestReconciler()
+
+	t.Run("No windows", func(t *testing.T) {
+		// Act
+		plan := r.withMaintenanceWindow(rotate)(context.Background(), nil, api.DeploymentSpec{}, api.DeploymentStatus{}, nil)
+
+		// Assert
+		require.Len(t, plan, 2)
+	})
+
+	t.Run("Window open", func(t *testing.T) {
+		// Act
+		plan := r.withMaintenanceWindow(rotate)(context.Background(), nil, api.DeploymentSpec{MaintenanceWindows: open}, api.DeploymentStatus{}, nil)
+
+		// Assert
+		require.Len(t, plan, 2)
+	})
+
+	t.Run("Window closed", func(t *testing.T) {
+		// Act
+		plan := r.withMaintenanceWindow(rotate)(context.Background(), nil, api.DeploymentSpec{MaintenanceWindows: closed}, api.DeploymentStatus{}, nil)
+
+		// Assert
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeSetConditionV2, plan[0].Type)
+		require.Equal(t, string(api.ConditionTypePendingMaintenanceWindow), plan[0].Params[setConditionActionV2KeyAction])
+	})
+
+	t.Run("Window closed with condition", func(t *testing.T) {
+		// Arrange
+		status := api.DeploymentStatus{
+			Conditions: api.ConditionList{
+				{
+					Type:   api.ConditionTypePendingMaintenanceWindow,
+					Status: core.ConditionTrue,
+				},
+			},
+		}
+
+		// Act
+		plan := r.withMaintenanceWindow(rotate)(context.Background(), nil, api.DeploymentSpec{MaintenanceWindows: closed}, status, nil)
+		conditionPlan := r.createMaintenanceWindowConditionPlan(context.Background(), nil, api.DeploymentSpec{MaintenanceWindows: closed}, status, nil)
+
+		// Assert
+		require.Empty(t, plan)
+		require.Empty(t, conditionPlan)
+	})
+
+	t.Run("Condition removed once window is open", func(t *testing.T) {
+		// Arrange
+		status := api.DeploymentStatus{
+			Conditions: api.ConditionList{
+				{
+					Type:   api.ConditionTypePendingMaintenanceWindow,
+					Status: core.ConditionTrue,
+				},
+			},
+		}
+
+		// Act
+		plan := r.createMaintenanceWindowConditionPlan(context.Background(), nil, api.DeploymentSpec{MaintenanceWindows: open}, status, nil)
+
+		// Assert
+		require.Len(t, plan, 1)
+		require.Equal(t, setConditionActionV2KeyTypeRemove, plan[0].Params[setConditionActionV2KeyType])
+	})
+}
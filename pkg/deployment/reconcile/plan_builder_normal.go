@@ -50,7 +50,7 @@ func (r *Reconciler) createNormalPlan(ctx context.Context, apiObject k8sutil.API
 		// Check for failed members
 		ApplyIfEmpty(r.createMemberFailedRestoreNormalPlan).
 		// Check for scale up/down
-		ApplyIfEmpty(r.createScaleMemberPlan).
+		ApplyIfEmpty(r.withMaintenanceWindow(r.createScaleMemberPlan)).
 		// Update status
 		ApplySubPlanIfEmpty(r.createEncryptionKeyStatusPropagatedFieldUpdate, r.createEncryptionKeyStatusUpdate).
 		ApplyIfEmpty(r.createTLSStatusUpdate).
@@ -62,18 +62,22 @@ func (r *Reconciler) createNormalPlan(ctx context.Context, apiObject k8sutil.API
 		// Check for the need to rotate one or more members
 		ApplyIfEmpty(r.createMarkToRemovePlan).
 		ApplyIfEmpty(r.createMemberMaintenanceManagementPlan).
-		ApplyIfEmpty(r.createRotateOrUpgradePlan).
+		ApplyIfEmpty(r.withMaintenanceWindow(r.createRotateOrUpgradePlan)).
 		// Disable maintenance if upgrade process was done. Upgrade task throw IDLE Action if upgrade is pending
 		ApplyIfEmpty(r.createMaintenanceManagementPlan).
 		// Add keys
-		ApplySubPlanIfEmpty(r.createEncryptionKeyStatusPropagatedFieldUpdate, r.createEncryptionKey).
-		ApplyIfEmpty(r.createJWTKeyUpdate).
-		ApplySubPlanIfEmpty(r.createTLSStatusPropagatedFieldUpdate, r.createCARenewalPlan).
-		ApplySubPlanIfEmpty(r.createTLSStatusPropagatedFieldUpdate, r.createCAAppendPlan).
-		ApplyIfEmpty(r.createKeyfileRenewalPlan).
-		ApplyIfEmpty(r.createRotateServerStorageResizePlanRotate).
-		ApplySubPlanIfEmpty(r.createTLSStatusPropagatedFieldUpdate, r.createRotateTLSServerSNIPlan).
+		ApplySubPlanIfEmpty(r.createEncryptionKeyStatusPropagatedFieldUpdate, r.withMaintenanceWindow(r.createEncryptionKey)).
+		ApplyIfEmpty(r.withMaintenanceWindow(r.createJWTKeyUpdate)).
+		ApplySubPlanIfEmpty(r.createTLSStatusPropagatedFieldUpdate, r.withMaintenanceWindow(r.createCARenewalPlan)).
+		ApplySubPlanIfEmpty(r.createTLSStatusPropagatedFieldUpdate, r.withMaintenanceWindow(r.createCAAppendPlan)).
+		ApplyIfEmpty(r.withMaintenanceWindow(r.createKeyfileRenewalPlan)).
+		ApplyIfEmpty(r.withMaintenanceWindow(r.createRotateServerStorageResizePlanRotate)).
+		ApplyIfEmpty(r.createVolumeAutoGrowPlan).
+		ApplySubPlanIfEmpty(r.createTLSStatusPropagatedFieldUpdate, r.withMaintenanceWindow(r.createRotateTLSServerSNIPlan)).
 		ApplyIfEmpty(r.createRestorePlan).
+		ApplyIfEmpty(r.createArangoRestorePlan).
+		// Execute ArangoTasks
+		ApplyIfEmpty(r.withMaintenanceWindow(r.createArangoTaskPlan)).
 		ApplySubPlanIfEmpty(r.createEncryptionKeyStatusPropagatedFieldUpdate, r.createEncryptionKeyCleanPlan).
 		ApplySubPlanIfEmpty(r.createTLSStatusPropagatedFieldUpdate, r.createCACleanPlan).
 		ApplyIfEmpty(r.createClusterOperationPlan).
@@ -23,19 +23,87 @@ package reconcile
 
 import (
 	"context"
+	"time"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/acs/sutil"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
 func (r *Reconciler) createRebalancerGeneratePlan(ctx context.Context, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
 	context PlanBuilderContext) api.Plan {
-	return nil
+	if !spec.Rebalancer.IsEnabled() || spec.GetMode() != api.DeploymentModeCluster {
+		return nil
+	}
+
+	if status.Rebalancer.IsMoveInProgress() {
+		return nil
+	}
+
+	cache, ok := context.GetAgencyCache()
+	if !ok {
+		r.log.Debug("AgencyCache is not ready")
+		return nil
+	}
+
+	if len(cache.Target.JobToDo) > 0 || len(cache.Target.JobPending) > 0 {
+		// Wait for the agency jobs to be finished
+		return nil
+	}
+
+	if moves := rebalancerPlanMoves(spec, status, cache, context.ACS()); len(moves) == 0 {
+		return nil
+	}
+
+	return api.Plan{actions.NewClusterAction(api.ActionTypeRebalancerGenerate, "Shards are not balanced")}
 }
 
 func (r *Reconciler) createRebalancerCheckPlan(ctx context.Context, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
 	context PlanBuilderContext) api.Plan {
-	return nil
+	r.metrics.GetRebalancer().SetEnabled(spec.Rebalancer.IsEnabled())
+
+	if !spec.Rebalancer.IsEnabled() {
+		if status.Rebalancer != nil {
+			return api.Plan{actions.NewClusterAction(api.ActionTypeRebalancerClean, "Rebalancer is disabled")}
+		}
+
+		return nil
+	}
+
+	if !status.Rebalancer.IsMoveInProgress() {
+		return nil
+	}
+
+	cache, ok := context.GetAgencyCache()
+	if !ok {
+		r.log.Debug("AgencyCache is not ready")
+		return nil
+	}
+
+	if remaining, _, _ := rebalancerCheckJobs(status.Rebalancer, cache, time.Now()); len(remaining) == len(status.Rebalancer.MoveJobs) {
+		return nil
+	}
+
+	return api.Plan{actions.NewClusterAction(api.ActionTypeRebalancerCheck, "Move jobs state changed")}
+}
+
+// rebalancerPlanMoves returns moves which should be executed to balance the deployment
+func rebalancerPlanMoves(spec api.DeploymentSpec, status api.DeploymentStatus, cache agency.State, acs sutil.ACS) []rebalancerMove {
+	leader := true
+	var zones agency.ServerZones
+
+	if spec.Rebalancer != nil {
+		leader = spec.Rebalancer.Optimizers.IsLeaderEnabled()
+
+		if spec.Rebalancer.Optimizers.IsZonesEnabled() {
+			zones = getDBServerZones(acs, spec, status)
+		}
+	}
+
+	return rebalancerGenerateMoves(cache, rebalancerServers(status, cache),
+		spec.Rebalancer.GetParallelMoves(rebalancerDefaultParallelMoves), leader, zones)
 }
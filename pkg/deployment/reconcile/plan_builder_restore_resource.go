@@ -0,0 +1,276 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/go-driver"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+const restoreActionParam = "restore"
+
+// createArangoRestorePlan creates restore plan for the oldest pending ArangoRestore resource of the deployment.
+func (r *Reconciler) createArangoRestorePlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	if spec.RestoreFrom != nil || status.Restore != nil {
+		// Restore requested in the deployment spec needs to be finished first
+		return nil
+	}
+
+	restores, err := context.ListRestores(ctx)
+	if err != nil {
+		r.planLogger.Err(err).Warn("Unable to list restores")
+		return nil
+	}
+
+	var pending *backupApi.ArangoRestore
+
+	for id := range restores {
+		restore := &restores[id]
+
+		switch phase := restore.Status.Phase; {
+		case phase == backupApi.ArangoRestorePhaseRestoring:
+			// Plan is empty, so the restore action is not running anymore
+			r.updateArangoRestore(ctx, context, restore.DeepCopy(), backupApi.ArangoRestorePhaseFailed, "Restore has been interrupted")
+		case phase.IsPending():
+			if pending == nil || restore.CreationTimestamp.Before(&pending.CreationTimestamp) {
+				pending = restore
+			}
+		}
+	}
+
+	if pending == nil {
+		return nil
+	}
+
+	restore := pending.DeepCopy()
+
+	if err := restore.Validate(); err != nil {
+		r.updateArangoRestore(ctx, context, restore, backupApi.ArangoRestorePhaseFailed, err.Error())
+		return nil
+	}
+
+	backup, err := context.GetBackup(ctx, restore.Spec.Backup.Name)
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			r.updateArangoRestore(ctx, context, restore, backupApi.ArangoRestorePhaseFailed, fmt.Sprintf("Backup %s not found", restore.Spec.Backup.Name))
+			return nil
+		}
+
+		r.planLogger.Err(err).Warn("Unable to get backup")
+		return nil
+	}
+
+	if backup.Status.Backup == nil {
+		r.updateArangoRestore(ctx, context, restore, backupApi.ArangoRestorePhasePending, "Backup not yet ready")
+		return nil
+	}
+
+	if status.CurrentImage == nil {
+		r.updateArangoRestore(ctx, context, restore, backupApi.ArangoRestorePhasePending, "Deployment image not yet known")
+		return nil
+	}
+
+	if spec.RocksDB.IsEncrypted() && !isEncryptionPropagated(status) {
+		// Same as for the restore requested in the deployment spec, restore needs to wait for propagated keys
+		r.updateArangoRestore(ctx, context, restore, backupApi.ArangoRestorePhasePending, "Encryption keys not yet propagated")
+		return nil
+	}
+
+	if !checkArangoRestore(spec, status, backup.Status.Backup, &restore.Status.Conditions) {
+		message := "Pre-flight checks failed"
+		for _, c := range restore.Status.Conditions {
+			if !c.IsTrue() {
+				message = c.Message
+				break
+			}
+		}
+
+		context.CreateEvent(k8sutil.NewRestoreRejectedEvent(apiObject, restore.GetName(), message))
+		r.updateArangoRestore(ctx, context, restore, backupApi.ArangoRestorePhaseFailed, message)
+		return nil
+	}
+
+	if restore.Status.Conditions.Equal(pending.Status.Conditions) {
+		if !r.updateArangoRestore(ctx, context, restore, backupApi.ArangoRestorePhasePending, "") {
+			return nil
+		}
+	} else if !r.saveArangoRestore(ctx, context, restore, backupApi.ArangoRestorePhasePending, "") {
+		// Results of the pre-flight checks changed
+		return nil
+	}
+
+	return arangoRestorePlan(spec, restore.GetName())
+}
+
+// updateArangoRestore sets phase and message of the restore. Status is saved only if phase or message changed.
+// Returns true if status is up to date.
+func (r *Reconciler) updateArangoRestore(ctx context.Context, context PlanBuilderContext, restore *backupApi.ArangoRestore,
+	phase backupApi.ArangoRestorePhase, message string) bool {
+	if restore.Status.Phase == phase && restore.Status.Message == message {
+		return true
+	}
+
+	return r.saveArangoRestore(ctx, context, restore, phase, message)
+}
+
+// saveArangoRestore sets phase and message of the restore and saves the status. Returns true if status has been saved.
+func (r *Reconciler) saveArangoRestore(ctx context.Context, context PlanBuilderContext, restore *backupApi.ArangoRestore,
+	phase backupApi.ArangoRestorePhase, message string) bool {
+	restore.Status.Phase = phase
+	restore.Status.Message = message
+
+	if phase.IsFinal() {
+		now := meta.Now()
+		restore.Status.CompletionTime = &now
+	}
+
+	if err := context.UpdateRestoreStatus(ctx, restore); err != nil {
+		r.planLogger.Err(err).Str("restore", restore.GetName()).Warn("Unable to update restore status")
+		return false
+	}
+
+	return true
+}
+
+func arangoRestorePlan(spec api.DeploymentSpec, restore string) api.Plan {
+	p := api.Plan{
+		actions.NewClusterAction(api.ActionTypeArangoRestore).AddParam(restoreActionParam, restore),
+	}
+
+	switch spec.Mode.Get() {
+	case api.DeploymentModeActiveFailover:
+		p = withMaintenance(p...)
+	}
+
+	return p
+}
+
+// checkArangoRestore runs pre-flight checks of the restore and saves the results in the conditions.
+// Returns true if all checks passed.
+func checkArangoRestore(spec api.DeploymentSpec, status api.DeploymentStatus, backup *backupApi.ArangoBackupDetails, conditions *api.ConditionList) bool {
+	ok := true
+
+	if compatible, message := checkArangoRestoreVersion(status.CurrentImage, backup); compatible {
+		conditions.Update(backupApi.ConditionTypeRestoreVersionCompatible, true, "Version Compatible", message)
+	} else {
+		conditions.Update(backupApi.ConditionTypeRestoreVersionCompatible, false, "Version Incompatible", message)
+		ok = false
+	}
+
+	if match, message := checkArangoRestoreDBServers(spec, status, backup); match {
+		conditions.Update(backupApi.ConditionTypeRestoreDBServersMatch, true, "DBServers Match", message)
+	} else {
+		conditions.Update(backupApi.ConditionTypeRestoreDBServersMatch, false, "DBServers Mismatch", message)
+		ok = false
+	}
+
+	if match, message := checkArangoRestoreEncryptionKeys(spec, status, backup); match {
+		conditions.Update(backupApi.ConditionTypeRestoreEncryptionKeysMatch, true, "Encryption Keys Match", message)
+	} else {
+		conditions.Update(backupApi.ConditionTypeRestoreEncryptionKeysMatch, false, "Encryption Keys Mismatch", message)
+		ok = false
+	}
+
+	return ok
+}
+
+// checkArangoRestoreVersion checks if backup was created with the same major and minor version as the deployment runs.
+func checkArangoRestoreVersion(image *api.ImageInfo, backup *backupApi.ArangoBackupDetails) (bool, string) {
+	if image == nil {
+		return false, "Deployment version is unknown"
+	}
+
+	if backup.Version == "" {
+		return false, "Backup version is unknown"
+	}
+
+	from, to := driver.Version(backup.Version), image.ArangoDBVersion
+
+	if from.Major() != to.Major() || from.Minor() != to.Minor() {
+		return false, fmt.Sprintf("Backup version %s can not be restored on version %s", from, to)
+	}
+
+	return true, ""
+}
+
+// checkArangoRestoreDBServers checks if backup was created with the same number of DBServers.
+func checkArangoRestoreDBServers(spec api.DeploymentSpec, status api.DeploymentStatus, backup *backupApi.ArangoBackupDetails) (bool, string) {
+	if spec.Mode.Get() != api.DeploymentModeCluster {
+		return true, ""
+	}
+
+	if current := len(status.Members.DBServers); int(backup.NumberOfDBServers) != current {
+		return false, fmt.Sprintf("Backup has been created with %d DBServers, deployment has %d", backup.NumberOfDBServers, current)
+	}
+
+	return true, ""
+}
+
+// checkArangoRestoreEncryptionKeys checks if the encryption key used by the backup is known to the deployment.
+func checkArangoRestoreEncryptionKeys(spec api.DeploymentSpec, status api.DeploymentStatus, backup *backupApi.ArangoBackupDetails) (bool, string) {
+	if len(backup.Keys) == 0 {
+		return true, ""
+	}
+
+	if !spec.RocksDB.IsEncrypted() {
+		return false, "Backup is encrypted, but deployment does not use encryption"
+	}
+
+	if !isEncryptionPropagated(status) {
+		return false, "Encryption keys are not yet propagated"
+	}
+
+	keys := status.Hashes.Encryption.Keys
+	if len(keys) == 0 {
+		// Keys are not reported by this version, restore will be verified by the ArangoDB
+		return true, ""
+	}
+
+	for _, key := range backup.Keys {
+		if keys.Contains(key) {
+			return true, ""
+		}
+	}
+
+	return false, "None of the backup encryption keys is present in the deployment"
+}
+
+// isEncryptionPropagated returns false if encryption keys of the deployment are managed by the operator,
+// but they are not yet propagated to all members.
+func isEncryptionPropagated(status api.DeploymentStatus) bool {
+	if i := status.CurrentImage; i != nil && features.EncryptionRotation().Supported(i.ArangoDBVersion, i.Enterprise) {
+		return status.Hashes.Encryption.Propagated
+	}
+
+	return true
+}
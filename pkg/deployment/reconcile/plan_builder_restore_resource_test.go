@@ -0,0 +1,255 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/go-driver"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_ArangoRestore_PreFlightChecks(t *testing.T) {
+	type testCase struct {
+		spec   api.DeploymentSpec
+		status api.DeploymentStatus
+		backup backupApi.ArangoBackupDetails
+
+		ok     bool
+		failed []api.ConditionType
+	}
+
+	newStatus := func(version string, dbservers int, keys ...string) api.DeploymentStatus {
+		var s api.DeploymentStatus
+
+		s.CurrentImage = &api.ImageInfo{
+			ArangoDBVersion: driver.Version(version),
+		}
+
+		for i := 0; i < dbservers; i++ {
+			s.Members.DBServers = append(s.Members.DBServers, api.MemberStatus{ID: string(rune('a' + i))})
+		}
+
+		s.Hashes.Encryption.Keys = keys
+
+		return s
+	}
+
+	cluster := api.DeploymentSpec{
+		Mode: api.NewMode(api.DeploymentModeCluster),
+	}
+
+	encrypted := api.DeploymentSpec{
+		Mode: api.NewMode(api.DeploymentModeCluster),
+		RocksDB: api.RocksDBSpec{
+			Encryption: api.RocksDBEncryptionSpec{
+				KeySecretName: util.NewString("secret"),
+			},
+		},
+	}
+
+	testCases := map[string]testCase{
+		"All checks passed": {
+			spec:   cluster,
+			status: newStatus("3.8.1", 3),
+			backup: backupApi.ArangoBackupDetails{Version: "3.8.0", NumberOfDBServers: 3},
+
+			ok: true,
+		},
+		"Version mismatch": {
+			spec:   cluster,
+			status: newStatus("3.9.0", 3),
+			backup: backupApi.ArangoBackupDetails{Version: "3.8.0", NumberOfDBServers: 3},
+
+			failed: []api.ConditionType{backupApi.ConditionTypeRestoreVersionCompatible},
+		},
+		"Unknown backup version": {
+			spec:   cluster,
+			status: newStatus("3.9.0", 3),
+			backup: backupApi.ArangoBackupDetails{NumberOfDBServers: 3},
+
+			failed: []api.ConditionType{backupApi.ConditionTypeRestoreVersionCompatible},
+		},
+		"DBServers mismatch": {
+			spec:   cluster,
+			status: newStatus("3.8.1", 5),
+			backup: backupApi.ArangoBackupDetails{Version: "3.8.0", NumberOfDBServers: 3},
+
+			failed: []api.ConditionType{backupApi.ConditionTypeRestoreDBServersMatch},
+		},
+		"DBServers ignored in single mode": {
+			spec:   api.DeploymentSpec{Mode: api.NewMode(api.DeploymentModeSingle)},
+			status: newStatus("3.8.1", 0),
+			backup: backupApi.ArangoBackupDetails{Version: "3.8.0", NumberOfDBServers: 1},
+
+			ok: true,
+		},
+		"Encrypted backup on not encrypted deployment": {
+			spec:   cluster,
+			status: newStatus("3.8.1", 3),
+			backup: backupApi.ArangoBackupDetails{Version: "3.8.0", NumberOfDBServers: 3, Keys: shared.HashList{"sha256:a"}},
+
+			failed: []api.ConditionType{backupApi.ConditionTypeRestoreEncryptionKeysMatch},
+		},
+		"Encryption key missing": {
+			spec:   encrypted,
+			status: newStatus("3.8.1", 3, "sha256:b"),
+			backup: backupApi.ArangoBackupDetails{Version: "3.8.0", NumberOfDBServers: 3, Keys: shared.HashList{"sha256:a"}},
+
+			failed: []api.ConditionType{backupApi.ConditionTypeRestoreEncryptionKeysMatch},
+		},
+		"Encryption key present": {
+			spec:   encrypted,
+			status: newStatus("3.8.1", 3, "sha256:a", "sha256:b"),
+			backup: backupApi.ArangoBackupDetails{Version: "3.8.0", NumberOfDBServers: 3, Keys: shared.HashList{"sha256:a"}},
+
+			ok: true,
+		},
+		"Encryption keys not propagated": {
+			spec: encrypted,
+			status: func() api.DeploymentStatus {
+				s := newStatus("3.8.1", 3, "sha256:a")
+				s.CurrentImage.Enterprise = true
+				return s
+			}(),
+			backup: backupApi.ArangoBackupDetails{Version: "3.8.0", NumberOfDBServers: 3, Keys: shared.HashList{"sha256:a"}},
+
+			failed: []api.ConditionType{backupApi.ConditionTypeRestoreEncryptionKeysMatch},
+		},
+		"Encryption keys propagated": {
+			spec: encrypted,
+			status: func() api.DeploymentStatus {
+				s := newStatus("3.8.1", 3, "sha256:a")
+				s.CurrentImage.Enterprise = true
+				s.Hashes.Encryption.Propagated = true
+				return s
+			}(),
+			backup: backupApi.ArangoBackupDetails{Version: "3.8.0", NumberOfDBServers: 3, Keys: shared.HashList{"sha256:a"}},
+
+			ok: true,
+		},
+		"Multiple failures": {
+			spec:   encrypted,
+			status: newStatus("3.7.0", 2, "sha256:b"),
+			backup: backupApi.ArangoBackupDetails{Version: "3.8.0", NumberOfDBServers: 3, Keys: shared.HashList{"sha256:a"}},
+
+			failed: []api.ConditionType{
+				backupApi.ConditionTypeRestoreVersionCompatible,
+				backupApi.ConditionTypeRestoreDBServersMatch,
+				backupApi.ConditionTypeRestoreEncryptionKeysMatch,
+			},
+		},
+	}
+
+	*features.EncryptionRotation().EnabledPointer() = true
+	defer func() {
+		*features.EncryptionRotation().EnabledPointer() = false
+	}()
+
+	for n, c := range testCases {
+		t.Run(n, func(t *testing.T) {
+			// Arrange
+			var conditions api.ConditionList
+
+			// Act
+			ok := checkArangoRestore(c.spec, c.status, &c.backup, &conditions)
+
+			// Assert
+			require.Equal(t, c.ok, ok)
+			require.Len(t, conditions, 3)
+
+			for _, cond := range conditions {
+				require.Equal(t, !containsConditionType(c.failed, cond.Type), cond.IsTrue(), cond.Type)
+			}
+		})
+	}
+}
+
+func Test_ArangoRestore_StatusUpdate(t *testing.T) {
+	newContext := func(phase backupApi.ArangoRestorePhase, message string) *testContext {
+		return &testContext{
+			ArangoDeployment: &api.ArangoDeployment{
+				ObjectMeta: meta.ObjectMeta{Name: "test"},
+			},
+			Backup: &backupApi.ArangoBackup{
+				ObjectMeta: meta.ObjectMeta{Name: "backup"},
+			},
+			Restores: []backupApi.ArangoRestore{
+				{
+					ObjectMeta: meta.ObjectMeta{Name: "restore"},
+					Spec: backupApi.ArangoRestoreSpec{
+						Deployment: backupApi.ArangoBackupSpecDeployment{Name: "test"},
+						Backup:     backupApi.ArangoRestoreSpecBackup{Name: "backup"},
+					},
+					Status: backupApi.ArangoRestoreStatus{
+						Phase:   phase,
+						Message: message,
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("New restore", func(t *testing.T) {
+		// Arrange
+		c := newContext(backupApi.ArangoRestorePhaseNone, "")
+
+		// Act
+		plan := newTestReconciler().createArangoRestorePlan(context.Background(), c.ArangoDeployment, c.GetSpec(), c.GetStatus(), c)
+
+		// Assert
+		require.Empty(t, plan)
+		require.Len(t, c.UpdatedRestores, 1)
+		require.Equal(t, backupApi.ArangoRestorePhasePending, c.UpdatedRestores[0].Status.Phase)
+		require.Equal(t, "Backup not yet ready", c.UpdatedRestores[0].Status.Message)
+	})
+
+	t.Run("Status not changed", func(t *testing.T) {
+		// Arrange
+		c := newContext(backupApi.ArangoRestorePhasePending, "Backup not yet ready")
+
+		// Act
+		plan := newTestReconciler().createArangoRestorePlan(context.Background(), c.ArangoDeployment, c.GetSpec(), c.GetStatus(), c)
+
+		// Assert
+		require.Empty(t, plan)
+		require.Empty(t, c.UpdatedRestores)
+	})
+}
+
+func containsConditionType(list []api.ConditionType, t api.ConditionType) bool {
+	for _, l := range list {
+		if l == t {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,145 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+)
+
+// getMaxParallelRotation returns number of members of the group which can be rotated at the same time, taken from the group maxUnavailable.
+func getMaxParallelRotation(spec api.DeploymentSpec, group api.ServerGroup) int {
+	return spec.GetServerGroupSpec(group).GetMaxUnavailable(group)
+}
+
+// getParallelRotationLimit returns number of members of the group which can be rotated together with the first one.
+// Members which are already unavailable are counted into maxUnavailable. PDB of the group is not taken into account,
+// pods are deleted by the operator and not evicted, and for DBServers restart is limited by the shards write concern.
+func getParallelRotationLimit(spec api.DeploymentSpec, status api.DeploymentStatus, first api.DeploymentStatusMemberElement) int {
+	limit := getMaxParallelRotation(spec, first.Group)
+
+	for _, e := range status.Members.AsListInGroup(first.Group) {
+		if e.Member.ID == first.Member.ID {
+			continue
+		}
+
+		if !e.Member.Conditions.IsTrue(api.ConditionTypeReady) {
+			limit--
+		}
+	}
+
+	return limit
+}
+
+// selectParallelRotationMembers returns members of the group which can be rotated together with the first one.
+// First member is always returned. Other members are added only if restart is allowed for them and, in case of DBServers,
+// restart of all selected members at once does not break the write concern of any shard.
+func (r *Reconciler) selectParallelRotationMembers(spec api.DeploymentSpec, status api.DeploymentStatus, context PlanBuilderContext,
+	decision updateUpgradeDecisionMap, first api.DeploymentStatusMemberElement, candidate func(d updateUpgradeDecision) bool) api.DeploymentStatusMemberElements {
+	members := api.DeploymentStatusMemberElements{first}
+
+	limit := getParallelRotationLimit(spec, status, first)
+	if limit <= 1 || !decision[first.Member.ID].updateAllowed {
+		return members
+	}
+
+	var agencyState agency.State
+	if first.Group == api.ServerGroupDBServers {
+		state, ok := context.GetAgencyCache()
+		if !ok {
+			// Unable to get agency state, rotate only one member
+			return members
+		}
+		agencyState = state
+	}
+
+	servers := agency.Servers{agency.Server(first.Member.ID)}
+
+	for _, e := range status.Members.AsListInGroup(first.Group) {
+		if len(members) >= limit {
+			break
+		}
+
+		if e.Member.ID == first.Member.ID {
+			continue
+		}
+
+		d := decision[e.Member.ID]
+		if !d.updateAllowed || !candidate(d) {
+			continue
+		}
+
+		if e.Group == api.ServerGroupDBServers {
+			if blocking := agency.GetDBServersBlockingRestartShards(agencyState, append(servers, agency.Server(e.Member.ID))...); len(blocking) > 0 {
+				r.planLogger.Str("member", e.Member.ID).Int("shards", len(blocking)).Debug("Member cannot be rotated in parallel")
+				continue
+			}
+		}
+
+		servers = append(servers, agency.Server(e.Member.ID))
+		members = append(members, e)
+	}
+
+	return members
+}
+
+// parallelRotationPlan merges rotation plans of the members. All members are shut down first
+// and then operator waits for all of them to be up and in sync again.
+func parallelRotationPlan(plans ...api.Plan) api.Plan {
+	if len(plans) == 1 {
+		return plans[0]
+	}
+
+	var shutdown, startup api.Plan
+	shutdownClusterActions := map[api.ActionType]bool{}
+	startupClusterActions := map[api.ActionType]bool{}
+
+	for _, p := range plans {
+		id := len(p)
+		for i, a := range p {
+			if a.Type == api.ActionTypeWaitForMemberUp {
+				id = i
+				break
+			}
+		}
+
+		shutdown = appendParallelRotationActions(shutdown, shutdownClusterActions, p[:id])
+		startup = appendParallelRotationActions(startup, startupClusterActions, p[id:])
+	}
+
+	return append(shutdown, startup...)
+}
+
+// appendParallelRotationActions appends actions to the plan. Cluster actions are executed only once, so duplicates are skipped.
+func appendParallelRotationActions(plan api.Plan, clusterActions map[api.ActionType]bool, actions api.Plan) api.Plan {
+	for _, a := range actions {
+		if a.MemberID == "" {
+			if clusterActions[a.Type] {
+				continue
+			}
+			clusterActions[a.Type] = true
+		}
+
+		plan = append(plan, a)
+	}
+
+	return plan
+}
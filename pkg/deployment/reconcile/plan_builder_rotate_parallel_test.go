@@ -0,0 +1,197 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_ParallelRotation_Limit(t *testing.T) {
+	newSpec := func(env api.Environment, group api.ServerGroup, count int, maxUnavailable intstr.IntOrString) api.DeploymentSpec {
+		spec := api.DeploymentSpec{
+			Mode:        api.NewMode(api.DeploymentModeCluster),
+			Environment: api.NewEnvironment(env),
+		}
+		spec.UpdateServerGroupSpec(group, api.ServerGroupSpec{
+			Count:          util.NewInt(count),
+			MaxUnavailable: &maxUnavailable,
+		})
+		return spec
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		require.Equal(t, 1, getMaxParallelRotation(api.DeploymentSpec{}, api.ServerGroupCoordinators))
+	})
+
+	t.Run("Count", func(t *testing.T) {
+		require.Equal(t, 5, getMaxParallelRotation(newSpec(api.EnvironmentDevelopment, api.ServerGroupCoordinators, 20, intstr.FromInt(5)), api.ServerGroupCoordinators))
+	})
+
+	t.Run("Percent", func(t *testing.T) {
+		require.Equal(t, 5, getMaxParallelRotation(newSpec(api.EnvironmentDevelopment, api.ServerGroupCoordinators, 20, intstr.FromString("25%")), api.ServerGroupCoordinators))
+	})
+
+	t.Run("Percent rounded down", func(t *testing.T) {
+		require.Equal(t, 1, getMaxParallelRotation(newSpec(api.EnvironmentDevelopment, api.ServerGroupCoordinators, 3, intstr.FromString("10%")), api.ServerGroupCoordinators))
+	})
+
+	t.Run("Agents", func(t *testing.T) {
+		require.Equal(t, 1, getMaxParallelRotation(newSpec(api.EnvironmentDevelopment, api.ServerGroupAgents, 5, intstr.FromInt(3)), api.ServerGroupAgents))
+	})
+
+}
+
+func Test_ParallelRotation_Limit_Status(t *testing.T) {
+	newStatus := func(t *testing.T) (api.DeploymentSpec, api.DeploymentStatus) {
+		spec := api.DeploymentSpec{
+			Mode: api.NewMode(api.DeploymentModeCluster),
+			Coordinators: api.ServerGroupSpec{
+				Count:          util.NewInt(6),
+				MaxUnavailable: &[]intstr.IntOrString{intstr.FromInt(4)}[0],
+			},
+		}
+
+		var status api.DeploymentStatus
+		for _, id := range []string{"A", "B", "C", "D", "E", "F"} {
+			m := api.MemberStatus{ID: id}
+			m.Conditions.Update(api.ConditionTypeReady, true, "", "")
+			require.NoError(t, status.Members.Add(m, api.ServerGroupCoordinators))
+		}
+
+		return spec, status
+	}
+
+	first := func(status api.DeploymentStatus) api.DeploymentStatusMemberElement {
+		return api.DeploymentStatusMemberElement{Group: api.ServerGroupCoordinators, Member: status.Members.Coordinators[0]}
+	}
+
+	t.Run("All members ready", func(t *testing.T) {
+		spec, status := newStatus(t)
+
+		require.Equal(t, 4, getParallelRotationLimit(spec, status, first(status)))
+	})
+
+	t.Run("Unavailable members", func(t *testing.T) {
+		spec, status := newStatus(t)
+		status.Members.Coordinators[1].Conditions.Update(api.ConditionTypeReady, false, "", "")
+		status.Members.Coordinators[2].Conditions.Update(api.ConditionTypeReady, false, "", "")
+
+		require.Equal(t, 2, getParallelRotationLimit(spec, status, first(status)))
+	})
+
+	t.Run("First member unavailable", func(t *testing.T) {
+		spec, status := newStatus(t)
+		status.Members.Coordinators[0].Conditions.Update(api.ConditionTypeReady, false, "", "")
+
+		require.Equal(t, 4, getParallelRotationLimit(spec, status, first(status)))
+	})
+}
+
+func Test_ParallelRotation_Plan(t *testing.T) {
+	member := func(id string) api.Plan {
+		return api.Plan{
+			actions.NewClusterAction(api.ActionTypeSetCurrentImage),
+			actions.NewAction(api.ActionTypeKillMemberPod, api.ServerGroupCoordinators, withPredefinedMember(id)),
+			actions.NewAction(api.ActionTypeRotateMember, api.ServerGroupCoordinators, withPredefinedMember(id)),
+			actions.NewAction(api.ActionTypeWaitForMemberUp, api.ServerGroupCoordinators, withPredefinedMember(id)),
+			actions.NewAction(api.ActionTypeWaitForMemberInSync, api.ServerGroupCoordinators, withPredefinedMember(id)),
+		}
+	}
+
+	t.Run("Single member", func(t *testing.T) {
+		// Arrange
+		p := member("A")
+
+		// Act
+		plan := parallelRotationPlan(p)
+
+		// Assert
+		require.Equal(t, p, plan)
+	})
+
+	t.Run("Cluster actions after member is up", func(t *testing.T) {
+		withMaintenance := func(id string) api.Plan {
+			return api.Plan{
+				actions.NewClusterAction(api.ActionTypeEnableMaintenance),
+				actions.NewAction(api.ActionTypeKillMemberPod, api.ServerGroupDBServers, withPredefinedMember(id)),
+				actions.NewAction(api.ActionTypeWaitForMemberUp, api.ServerGroupDBServers, withPredefinedMember(id)),
+				actions.NewClusterAction(api.ActionTypeDisableMaintenance),
+			}
+		}
+
+		// Act
+		plan := parallelRotationPlan(withMaintenance("A"), withMaintenance("B"))
+
+		// Assert
+		expected := []struct {
+			action api.ActionType
+			member string
+		}{
+			{api.ActionTypeEnableMaintenance, ""},
+			{api.ActionTypeKillMemberPod, "A"},
+			{api.ActionTypeKillMemberPod, "B"},
+			{api.ActionTypeWaitForMemberUp, "A"},
+			{api.ActionTypeDisableMaintenance, ""},
+			{api.ActionTypeWaitForMemberUp, "B"},
+		}
+
+		require.Len(t, plan, len(expected))
+		for id, e := range expected {
+			require.Equal(t, e.action, plan[id].Type)
+			require.Equal(t, e.member, plan[id].MemberID)
+		}
+	})
+
+	t.Run("Multiple members", func(t *testing.T) {
+		// Act
+		plan := parallelRotationPlan(member("A"), member("B"))
+
+		// Assert
+		require.Len(t, plan, 9)
+
+		expected := []struct {
+			action api.ActionType
+			member string
+		}{
+			{api.ActionTypeSetCurrentImage, ""},
+			{api.ActionTypeKillMemberPod, "A"},
+			{api.ActionTypeRotateMember, "A"},
+			{api.ActionTypeKillMemberPod, "B"},
+			{api.ActionTypeRotateMember, "B"},
+			{api.ActionTypeWaitForMemberUp, "A"},
+			{api.ActionTypeWaitForMemberInSync, "A"},
+			{api.ActionTypeWaitForMemberUp, "B"},
+			{api.ActionTypeWaitForMemberInSync, "B"},
+		}
+
+		for id, e := range expected {
+			require.Equal(t, e.action, plan[id].Type)
+			require.Equal(t, e.member, plan[id].MemberID)
+		}
+	})
+}
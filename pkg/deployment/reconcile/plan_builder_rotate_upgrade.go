@@ -174,7 +174,16 @@ func (r *Reconciler) createUpdatePlanInternal(apiObject k8sutil.APIObject, spec
 		}
 
 		if m.Member.Conditions.IsTrue(api.ConditionTypeRestart) {
-			return r.createRotateMemberPlan(m.Member, m.Group, spec, "Restart flag present"), false
+			members := r.selectParallelRotationMembers(spec, status, context, decision, m, func(d updateUpgradeDecision) bool {
+				return d.update && d.restartRequired
+			})
+
+			plans := make([]api.Plan, len(members))
+			for id, e := range members {
+				plans[id] = r.createRotateMemberPlan(e.Member, e.Group, spec, "Restart flag present")
+			}
+
+			return parallelRotationPlan(plans...), false
 		}
 		arangoMember, ok := context.ACS().CurrentClusterCache().ArangoMember().V1().GetSimple(m.Member.ArangoMemberName(apiObject.GetName(), m.Group))
 		if !ok {
@@ -248,6 +257,13 @@ func (r *Reconciler) createUpgradePlanInternal(apiObject k8sutil.APIObject, spec
 		}
 	}
 
+	if spec.Upgrade.Get().Strategy.Get() == api.DeploymentUpgradeStrategyCanary {
+		// Canary members needs to be upgraded and verified first
+		if plan, done := r.createUpgradeCanaryPlan(spec, status, context, decision); !done {
+			return plan, false
+		}
+	}
+
 	// Upgrade phase
 	// During upgrade always get first member which needs to be upgraded
 	for _, m := range status.Members.AsList() {
@@ -275,7 +291,17 @@ func (r *Reconciler) createUpgradePlanInternal(apiObject k8sutil.APIObject, spec
 		if d.updateAllowed {
 			// We are fine, group is alive so we can proceed
 			r.planLogger.Str("member", m.Member.ID).Str("Reason", d.updateMessage).Info("Upgrade allowed")
-			return r.createUpgradeMemberPlan(m.Member, m.Group, "Version upgrade", spec, status, !d.upgradeDecision.AutoUpgradeNeeded), false
+
+			members := r.selectParallelRotationMembers(spec, status, context, decision, m, func(d updateUpgradeDecision) bool {
+				return d.upgrade && !d.upgradeDecision.Hold && d.upgradeDecision.UpgradeNeeded && d.upgradeDecision.UpgradeAllowed
+			})
+
+			plans := make([]api.Plan, len(members))
+			for id, e := range members {
+				plans[id] = r.createUpgradeMemberPlan(e.Member, e.Group, "Version upgrade", spec, status, !decision[e.Member.ID].upgradeDecision.AutoUpgradeNeeded)
+			}
+
+			return parallelRotationPlan(plans...), false
 		} else if d.unsafeUpdateAllowed {
 			r.planLogger.Str("member", m.Member.ID).Str("Reason", d.updateMessage).Info("Pod needs upgrade but cluster is not ready. Either some shards are not in sync or some member is not ready, but unsafe upgrade is allowed")
 			return r.createUpgradeMemberPlan(m.Member, m.Group, "Version upgrade", spec, status, !d.upgradeDecision.AutoUpgradeNeeded), false
@@ -27,6 +27,7 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/reconciler"
+	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
@@ -45,32 +46,32 @@ func (r *Reconciler) createScaleMemberPlan(ctx context.Context, apiObject k8suti
 	switch spec.GetMode() {
 	case api.DeploymentModeSingle:
 		// Never scale down
-		plan = append(plan, r.createScalePlan(status, status.Members.Single, api.ServerGroupSingle, 1, context).Filter(filterScaleUP)...)
+		plan = append(plan, r.createScalePlan(ctx, status, status.Members.Single, api.ServerGroupSingle, 1, context).Filter(filterScaleUP)...)
 	case api.DeploymentModeActiveFailover:
 		// Only scale agents & singles
 		if a := status.Agency; a != nil && a.Size != nil {
-			plan = append(plan, r.createScalePlan(status, status.Members.Agents, api.ServerGroupAgents, int(*a.Size), context).Filter(filterScaleUP)...)
+			plan = append(plan, r.createScalePlan(ctx, status, status.Members.Agents, api.ServerGroupAgents, int(*a.Size), context).Filter(filterScaleUP)...)
 		}
-		plan = append(plan, r.createScalePlan(status, status.Members.Single, api.ServerGroupSingle, spec.Single.GetCount(), context)...)
+		plan = append(plan, r.createScalePlan(ctx, status, status.Members.Single, api.ServerGroupSingle, spec.Single.GetCount(), context)...)
 	case api.DeploymentModeCluster:
 		// Scale agents, dbservers, coordinators
 		if a := status.Agency; a != nil && a.Size != nil {
-			plan = append(plan, r.createScalePlan(status, status.Members.Agents, api.ServerGroupAgents, int(*a.Size), context).Filter(filterScaleUP)...)
+			plan = append(plan, r.createScalePlan(ctx, status, status.Members.Agents, api.ServerGroupAgents, int(*a.Size), context).Filter(filterScaleUP)...)
 		}
-		plan = append(plan, r.createScalePlan(status, status.Members.DBServers, api.ServerGroupDBServers, spec.DBServers.GetCount(), context)...)
-		plan = append(plan, r.createScalePlan(status, status.Members.Coordinators, api.ServerGroupCoordinators, spec.Coordinators.GetCount(), context)...)
+		plan = append(plan, r.createScalePlan(ctx, status, status.Members.DBServers, api.ServerGroupDBServers, spec.DBServers.GetCount(), context)...)
+		plan = append(plan, r.createScalePlan(ctx, status, status.Members.Coordinators, api.ServerGroupCoordinators, spec.Coordinators.GetCount(), context)...)
 	}
 	if spec.GetMode().SupportsSync() {
 		// Scale syncmasters & syncworkers
-		plan = append(plan, r.createScalePlan(status, status.Members.SyncMasters, api.ServerGroupSyncMasters, spec.SyncMasters.GetCount(), context)...)
-		plan = append(plan, r.createScalePlan(status, status.Members.SyncWorkers, api.ServerGroupSyncWorkers, spec.SyncWorkers.GetCount(), context)...)
+		plan = append(plan, r.createScalePlan(ctx, status, status.Members.SyncMasters, api.ServerGroupSyncMasters, spec.SyncMasters.GetCount(), context)...)
+		plan = append(plan, r.createScalePlan(ctx, status, status.Members.SyncWorkers, api.ServerGroupSyncWorkers, spec.SyncWorkers.GetCount(), context)...)
 	}
 
 	return plan
 }
 
 // createScalePlan creates a scaling plan for a single server group
-func (r *Reconciler) createScalePlan(status api.DeploymentStatus, members api.MemberStatusList, group api.ServerGroup, count int, context PlanBuilderContext) api.Plan {
+func (r *Reconciler) createScalePlan(ctx context.Context, status api.DeploymentStatus, members api.MemberStatusList, group api.ServerGroup, count int, context PlanBuilderContext) api.Plan {
 	var plan api.Plan
 	if len(members) < count {
 		// Scale up
@@ -85,8 +86,25 @@ func (r *Reconciler) createScalePlan(status api.DeploymentStatus, members api.Me
 			Str("role", group.AsRole()).
 			Debug("Creating scale-up plan")
 	} else if len(members) > count {
+		// Usage is fetched once, when it is required by the selectors or by the clean out of the DBServer
+		var usage lazyDBServersUsage
+		var usageSelector api.MemberToRemoveSelector
+		if group == api.ServerGroupDBServers {
+			usage.load = func() dbserversUsage {
+				return r.getDBServersUsage(ctx, context, members)
+			}
+			usageSelector = usage.memberToRemoveSelector()
+		}
+
+		selectors := []api.MemberToRemoveSelector{
+			getCleanedServer(context),
+			topologyMissingMemberToRemoveSelector(status.Topology),
+			topologyAwarenessMemberToRemoveSelector(group, status.Topology, usageSelector),
+			usageSelector,
+		}
+
 		// Note, we scale down 1 member at a time
-		if m, err := members.SelectMemberToRemove(getCleanedServer(context), topologyMissingMemberToRemoveSelector(status.Topology), topologyAwarenessMemberToRemoveSelector(group, status.Topology)); err != nil {
+		if m, err := members.SelectMemberToRemove(selectors...); err != nil {
 			r.planLogger.Err(err).Str("role", group.AsRole()).Warn("Failed to select member to remove")
 		} else {
 			ready, message := groupReadyForRestart(context, status, m, group)
@@ -95,11 +113,22 @@ func (r *Reconciler) createScalePlan(status api.DeploymentStatus, members api.Me
 				return nil
 			}
 
+			if group == api.ServerGroupDBServers && m.Phase == api.MemberPhaseCreated {
+				if ok, message := usage.get().hasCapacityForCleanOut(m.ID); !ok {
+					r.planLogger.Str("member", m.ID).Str("role", group.AsRole()).Str("message", message).Warn("Unable to ScaleDown member")
+					return scaleDownBlockedPlan(status, m.ID, message)
+				}
+
+				if status.Conditions.IsTrue(api.ConditionTypeScaleDownBlocked) {
+					plan = append(plan, removeConditionActionV2("Remaining DBServers have enough capacity", api.ConditionTypeScaleDownBlocked))
+				}
+			}
+
 			r.planLogger.
 				Str("member-id", m.ID).
 				Str("phase", string(m.Phase)).
 				Debug("Found member to remove")
-			plan = append(plan, cleanOutMember(group, m)...)
+			plan = append(plan, usage.get().withDataBytes(m.ID, cleanOutMember(group, m))...)
 			r.planLogger.
 				Int("count", count).
 				Int("actual-count", len(members)).
@@ -107,10 +136,25 @@ func (r *Reconciler) createScalePlan(status api.DeploymentStatus, members api.Me
 				Str("member-id", m.ID).
 				Debug("Creating scale-down plan")
 		}
+	} else if group == api.ServerGroupDBServers {
+		if _, exists := status.Conditions.Get(api.ConditionTypeScaleDownBlocked); exists {
+			plan = append(plan, removeConditionActionV2("DBServers are not scaled down", api.ConditionTypeScaleDownBlocked))
+		}
 	}
 	return plan
 }
 
+// scaleDownBlockedPlan marks scale down of the DBServers as blocked
+func scaleDownBlockedPlan(status api.DeploymentStatus, id, message string) api.Plan {
+	hash := util.SHA256FromString(id)
+
+	if c, exists := status.Conditions.Get(api.ConditionTypeScaleDownBlocked); exists && c.IsTrue() && c.Hash == hash {
+		return nil
+	}
+
+	return api.Plan{updateConditionActionV2("Scale down blocked", api.ConditionTypeScaleDownBlocked, true, "Insufficient Capacity", message, hash)}
+}
+
 func (r *Reconciler) createReplaceMemberPlan(ctx context.Context, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
 	context PlanBuilderContext) api.Plan {
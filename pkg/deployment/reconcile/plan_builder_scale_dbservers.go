@@ -0,0 +1,217 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/client"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+)
+
+const (
+	// dbserverScaleDownReserve defines fraction of the capacity of remaining DBServers which needs to stay free after clean out
+	dbserverScaleDownReserve = 0.1
+
+	actionCleanOutMemberDataBytes = "dataBytes"
+)
+
+// dbserverUsage keeps usage of the DBServer
+type dbserverUsage struct {
+	// Shards defines number of the shard replicas planned on the DBServer
+	Shards int
+
+	// Disk defines if disk statistics were fetched from the DBServer
+	Disk bool
+	// DataBytes defines size of the data stored on the DBServer
+	DataBytes int64
+	// TotalBytes defines size of the volume
+	TotalBytes int64
+	// FreeBytes defines free space on the volume
+	FreeBytes int64
+}
+
+type dbserversUsage map[string]dbserverUsage
+
+// lazyDBServersUsage fetches usage of the DBServers once, when it is required for the first time
+type lazyDBServersUsage struct {
+	load func() dbserversUsage
+
+	usage dbserversUsage
+}
+
+// get returns usage of the DBServers, fetches it if it is not yet loaded
+func (l *lazyDBServersUsage) get() dbserversUsage {
+	if l.usage == nil && l.load != nil {
+		l.usage = l.load()
+	}
+
+	return l.usage
+}
+
+func (l *lazyDBServersUsage) memberToRemoveSelector() api.MemberToRemoveSelector {
+	return func(m api.MemberStatusList) (string, error) {
+		return l.get().memberToRemoveSelector()(m)
+	}
+}
+
+// getDBServersUsage returns usage of the DBServers, based on the agency cache and the engine statistics
+func (r *Reconciler) getDBServersUsage(ctx context.Context, context PlanBuilderContext, members api.MemberStatusList) dbserversUsage {
+	usage := dbserversUsage{}
+
+	var shards map[agency.Server]int
+	if cache, ok := context.GetAgencyCache(); ok {
+		shards = cache.PlanServerShards()
+	}
+
+	for _, m := range members {
+		u := dbserverUsage{
+			Shards: shards[agency.Server(m.ID)],
+		}
+
+		if m.Conditions.IsTrue(api.ConditionTypeReady) {
+			if stats, err := r.getMemberEngineStats(ctx, context, m.ID); err != nil {
+				r.planLogger.Err(err).Str("member", m.ID).Debug("Unable to get engine statistics")
+			} else {
+				u.Disk = true
+				u.DataBytes = stats.LiveSSTFilesSize
+				u.TotalBytes = stats.TotalDiskSpace
+				u.FreeBytes = stats.FreeDiskSpace
+			}
+		}
+
+		usage[m.ID] = u
+	}
+
+	return usage
+}
+
+func (r *Reconciler) getMemberEngineStats(ctx context.Context, context PlanBuilderContext, id string) (client.EngineStats, error) {
+	c, err := context.GetMembersState().GetMemberClient(id)
+	if err != nil {
+		return client.EngineStats{}, err
+	}
+
+	ctxChild, cancel := globals.GetGlobalTimeouts().ArangoD().WithTimeout(ctx)
+	defer cancel()
+
+	return client.NewClient(c.Connection(), r.log).GetEngineStats(ctxChild)
+}
+
+// memberToRemoveSelector selects the DBServer which is the cheapest to clean out.
+// Size of the data is used if it is known for all DBServers, otherwise number of the shards.
+func (u dbserversUsage) memberToRemoveSelector() api.MemberToRemoveSelector {
+	return func(m api.MemberStatusList) (string, error) {
+		if len(m) == 0 {
+			return "", nil
+		}
+
+		disk := true
+		ids := make([]string, 0, len(m))
+
+		for _, member := range m {
+			if member.Phase != api.MemberPhaseCreated {
+				continue
+			}
+
+			ids = append(ids, member.ID)
+
+			if !u[member.ID].Disk {
+				disk = false
+			}
+		}
+
+		if len(ids) == 0 {
+			return "", nil
+		}
+
+		sort.Slice(ids, func(i, j int) bool {
+			a, b := u[ids[i]], u[ids[j]]
+
+			if disk && a.DataBytes != b.DataBytes {
+				return a.DataBytes < b.DataBytes
+			}
+
+			if a.Shards != b.Shards {
+				return a.Shards < b.Shards
+			}
+
+			return ids[i] < ids[j]
+		})
+
+		return ids[0], nil
+	}
+}
+
+// hasCapacityForCleanOut checks if remaining DBServers have enough free space for the data of the given DBServer.
+// Returns true if the usage is not known.
+func (u dbserversUsage) hasCapacityForCleanOut(id string) (bool, string) {
+	removed, ok := u[id]
+	if !ok || !removed.Disk {
+		return true, ""
+	}
+
+	var free, total int64
+
+	for member, usage := range u {
+		if member == id {
+			continue
+		}
+
+		if !usage.Disk {
+			// Usage of the DBServer is not known
+			return true, ""
+		}
+
+		free += usage.FreeBytes
+		total += usage.TotalBytes
+	}
+
+	reserve := int64(float64(total) * dbserverScaleDownReserve)
+
+	if removed.DataBytes+reserve > free {
+		return false, fmt.Sprintf("Data of the DBServer %s (%d bytes) does not fit into free space of the remaining DBServers (%d bytes, %d bytes reserved)",
+			id, removed.DataBytes, free, reserve)
+	}
+
+	return true, ""
+}
+
+// withDataBytes adds estimated size of the data to the CleanOutMember actions
+func (u dbserversUsage) withDataBytes(id string, plan api.Plan) api.Plan {
+	usage, ok := u[id]
+	if !ok || !usage.Disk {
+		return plan
+	}
+
+	for i := range plan {
+		if plan[i].Type == api.ActionTypeCleanOutMember {
+			plan[i] = plan[i].AddParam(actionCleanOutMemberDataBytes, strconv.FormatInt(usage.DataBytes, 10))
+		}
+	}
+
+	return plan
+}
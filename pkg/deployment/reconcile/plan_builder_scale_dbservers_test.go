@@ -0,0 +1,178 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+func Test_DBServersUsage_MemberToRemoveSelector(t *testing.T) {
+	members := api.MemberStatusList{
+		{ID: "A", Phase: api.MemberPhaseCreated},
+		{ID: "B", Phase: api.MemberPhaseCreated},
+		{ID: "C", Phase: api.MemberPhaseCreated},
+	}
+
+	t.Run("Fewest bytes", func(t *testing.T) {
+		usage := dbserversUsage{
+			"A": {Shards: 1, Disk: true, DataBytes: 300},
+			"B": {Shards: 5, Disk: true, DataBytes: 100},
+			"C": {Shards: 3, Disk: true, DataBytes: 200},
+		}
+
+		id, err := usage.memberToRemoveSelector()(members)
+		require.NoError(t, err)
+		require.Equal(t, "B", id)
+	})
+
+	t.Run("Fewest shards if size is unknown", func(t *testing.T) {
+		usage := dbserversUsage{
+			"A": {Shards: 1, Disk: true, DataBytes: 300},
+			"B": {Shards: 5},
+			"C": {Shards: 3, Disk: true, DataBytes: 200},
+		}
+
+		id, err := usage.memberToRemoveSelector()(members)
+		require.NoError(t, err)
+		require.Equal(t, "A", id)
+	})
+
+	t.Run("Skip not created members", func(t *testing.T) {
+		usage := dbserversUsage{
+			"A": {Shards: 1},
+			"B": {Shards: 5},
+		}
+
+		id, err := usage.memberToRemoveSelector()(api.MemberStatusList{
+			{ID: "A", Phase: api.MemberPhaseCleanOut},
+			{ID: "B", Phase: api.MemberPhaseCreated},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "B", id)
+	})
+}
+
+func Test_DBServersUsage_HasCapacityForCleanOut(t *testing.T) {
+	t.Run("Enough space", func(t *testing.T) {
+		usage := dbserversUsage{
+			"A": {Disk: true, DataBytes: 400, TotalBytes: 1000, FreeBytes: 500},
+			"B": {Disk: true, DataBytes: 400, TotalBytes: 1000, FreeBytes: 500},
+		}
+
+		ok, _ := usage.hasCapacityForCleanOut("A")
+		require.True(t, ok)
+	})
+
+	t.Run("Reserve exceeded", func(t *testing.T) {
+		usage := dbserversUsage{
+			"A": {Disk: true, DataBytes: 450, TotalBytes: 1000, FreeBytes: 500},
+			"B": {Disk: true, DataBytes: 400, TotalBytes: 1000, FreeBytes: 500},
+		}
+
+		ok, message := usage.hasCapacityForCleanOut("A")
+		require.False(t, ok)
+		require.NotEmpty(t, message)
+	})
+
+	t.Run("Unknown usage", func(t *testing.T) {
+		usage := dbserversUsage{
+			"A": {Disk: true, DataBytes: 900, TotalBytes: 1000, FreeBytes: 100},
+			"B": {},
+		}
+
+		ok, _ := usage.hasCapacityForCleanOut("A")
+		require.True(t, ok)
+	})
+}
+
+func Test_DBServersUsage_Lazy(t *testing.T) {
+	newUsage := func() (*lazyDBServersUsage, *int) {
+		loads := 0
+		return &lazyDBServersUsage{
+			load: func() dbserversUsage {
+				loads++
+				return dbserversUsage{
+					"A": {Shards: 2},
+					"B": {Shards: 1},
+				}
+			},
+		}, &loads
+	}
+
+	t.Run("Selector not executed", func(t *testing.T) {
+		// Arrange
+		usage, loads := newUsage()
+		members := api.MemberStatusList{
+			{ID: "A", Phase: api.MemberPhaseCreated},
+			{ID: "B", Phase: api.MemberPhaseCreated},
+		}
+		members[0].Conditions.Update(api.ConditionTypeMarkedToRemove, true, "", "")
+
+		// Act
+		m, err := members.SelectMemberToRemove(usage.memberToRemoveSelector())
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, "A", m.ID)
+		require.Equal(t, 0, *loads)
+		require.Nil(t, usage.usage)
+	})
+
+	t.Run("Selector executed", func(t *testing.T) {
+		// Arrange
+		usage, loads := newUsage()
+		members := api.MemberStatusList{
+			{ID: "A", Phase: api.MemberPhaseCreated},
+			{ID: "B", Phase: api.MemberPhaseCreated},
+		}
+		for i := range members {
+			members[i].Conditions.Update(api.ConditionTypeReady, true, "", "")
+		}
+
+		// Act
+		m, err := members.SelectMemberToRemove(usage.memberToRemoveSelector(), usage.memberToRemoveSelector())
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, "B", m.ID)
+		require.Equal(t, 1, *loads)
+		require.NotNil(t, usage.usage)
+	})
+
+	t.Run("Loaded once", func(t *testing.T) {
+		// Arrange
+		usage, loads := newUsage()
+
+		// Act
+		first := usage.get()
+		second := usage.get()
+
+		// Assert
+		require.Equal(t, 1, *loads)
+		require.Equal(t, first, second)
+		ok, _ := second.hasCapacityForCleanOut("A")
+		require.True(t, ok)
+	})
+}
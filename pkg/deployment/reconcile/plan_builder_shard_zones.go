@@ -0,0 +1,114 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	core "k8s.io/api/core/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/acs/sutil"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/topology"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// createShardZonesConditionPlan discovers shards which replicas are not spread across zones of the DBServers
+func (r *Reconciler) createShardZonesConditionPlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	if spec.GetMode() != api.DeploymentModeCluster {
+		r.metrics.GetShards().SetZoneViolations(-1)
+		return nil
+	}
+
+	cache, ok := context.GetAgencyCache()
+	if !ok {
+		return nil
+	}
+
+	condition, exists := status.Conditions.Get(api.ConditionTypeShardsZoneDiverse)
+
+	zones := getDBServerZones(context.ACS(), spec, status)
+	if len(zones.Zones()) < 2 {
+		// DBServers are running in one zone or zones are unknown
+		r.metrics.GetShards().SetZoneViolations(-1)
+
+		if exists {
+			return api.Plan{removeConditionActionV2("DBServers are not spread across zones", api.ConditionTypeShardsZoneDiverse)}
+		}
+
+		return nil
+	}
+
+	violations := cache.GetShardsZoneViolations(zones)
+
+	r.metrics.GetShards().SetZoneViolations(len(violations))
+
+	hash, err := util.SHA256FromJSON(violations)
+	if err != nil {
+		r.planLogger.Err(err).Warn("Unable to calculate shard zones checksum")
+		return nil
+	}
+
+	if exists && condition.Hash == hash {
+		return nil
+	}
+
+	if len(violations) == 0 {
+		return api.Plan{updateConditionActionV2("Shards are zone diverse", api.ConditionTypeShardsZoneDiverse, true, "Shards Zone Diverse", "", hash)}
+	}
+
+	v := violations[0]
+	message := fmt.Sprintf("%d shards are not spread across zones, e.g. shard %s of %s/%s in %v", len(violations), v.Shard, v.Database, v.Collection, v.Zones)
+
+	return api.Plan{updateConditionActionV2("Shards are not zone diverse", api.ConditionTypeShardsZoneDiverse, false, "Shards Not Zone Diverse", message, hash)}
+}
+
+// getDBServerZones returns zones of the DBServers, taken from the topology label of the node on which DBServer is running
+func getDBServerZones(acs sutil.ACS, spec api.DeploymentSpec, status api.DeploymentStatus) agency.ServerZones {
+	locations, _ := topology.GetServerLocations(status.Members.DBServers, spec.Topology.GetLabel(),
+		func(m api.MemberStatus) (*core.Pod, error) {
+			if cache, ok := acs.ClusterCache(m.ClusterID); ok {
+				if pod, ok := cache.Pod().V1().GetSimple(m.Pod.GetName()); ok {
+					return pod, nil
+				}
+			}
+
+			return nil, nil
+		},
+		func(m api.MemberStatus, name string) (*core.Node, error) {
+			if cache, ok := acs.ClusterCache(m.ClusterID); ok {
+				if nodes, err := cache.Node().V1(); err == nil {
+					if node, ok := nodes.GetSimple(name); ok {
+						return node, nil
+					}
+				}
+			}
+
+			return nil, nil
+		})
+
+	return locations.Zones()
+}
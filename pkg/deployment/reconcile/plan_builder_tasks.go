@@ -0,0 +1,229 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// createArangoTaskStatusPlan accepts new ArangoTasks owned by the deployment
+// and fails running tasks which plan has been removed.
+func (r *Reconciler) createArangoTaskStatusPlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	var plan api.Plan
+
+	for _, task := range r.getArangoTasks(apiObject, context) {
+		switch task.Status.State {
+		case api.ArangoTaskUnknownState:
+			if _, err := r.createArangoTaskActions(spec, status, context, task); err != nil {
+				plan = append(plan, arangoTaskStatusUpdateAction(task, api.ArangoTaskFailedState, err.Error()))
+			} else {
+				plan = append(plan, arangoTaskStatusUpdateAction(task, api.ArangoTaskPendingState, ""))
+			}
+		case api.ArangoTaskRunningState:
+			if !isArangoTaskInPlan(status.Plan, task.GetUID()) && !isArangoTaskInPlan(status.HighPriorityPlan, task.GetUID()) {
+				plan = append(plan, arangoTaskStatusUpdateAction(task, api.ArangoTaskFailedState, "Plan of the task has been removed before completion"))
+			}
+		}
+	}
+
+	return plan
+}
+
+// createArangoTaskPlan creates plan for the oldest pending ArangoTask owned by the deployment.
+func (r *Reconciler) createArangoTaskPlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	for _, task := range r.getArangoTasks(apiObject, context) {
+		if task.Status.State != api.ArangoTaskPendingState {
+			continue
+		}
+
+		taskPlan, err := r.createArangoTaskActions(spec, status, context, task)
+		if err != nil {
+			return api.Plan{arangoTaskStatusUpdateAction(task, api.ArangoTaskFailedState, err.Error())}
+		}
+
+		plan := api.Plan{arangoTaskStatusUpdateAction(task, api.ArangoTaskRunningState, "")}.
+			After(taskPlan...).
+			After(arangoTaskStatusUpdateAction(task, api.ArangoTaskSuccessState, ""))
+
+		for id := range plan {
+			plan[id].TaskID = task.GetUID()
+		}
+
+		r.planLogger.Str("task", task.GetName()).Str("type", string(task.Spec.Type)).Info("Executing ArangoTask")
+
+		return plan
+	}
+
+	return nil
+}
+
+// createArangoTaskActions returns actions which execute the task. Error is returned when task cannot be executed.
+func (r *Reconciler) createArangoTaskActions(spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext, task *api.ArangoTask) (api.Plan, error) {
+	t := task.Spec
+	if s := task.Status.AcceptedSpec; s != nil {
+		t = *s
+	}
+
+	if err := t.Type.Validate(); err != nil {
+		return nil, err
+	}
+
+	reason := fmt.Sprintf("ArangoTask %s", task.GetName())
+
+	if t.Type.IsMemberTask() {
+		id, err := t.GetMemberID()
+		if err != nil {
+			return nil, err
+		}
+
+		member, group, ok := status.Members.ElementByID(id)
+		if !ok {
+			return nil, errors.Newf("Member %s does not exist", id)
+		}
+
+		switch t.Type {
+		case api.ArangoTaskRestartMemberType:
+			return r.createRotateMemberPlan(member, group, spec, reason), nil
+		case api.ArangoTaskResignLeadershipType:
+			if group != api.ServerGroupDBServers {
+				return nil, errors.Newf("Leadership can be resigned only by the DBServer")
+			}
+
+			return api.Plan{actions.NewAction(api.ActionTypeResignLeadership, group, member, reason)}, nil
+		case api.ArangoTaskCleanOutMemberType:
+			if group != api.ServerGroupDBServers {
+				return nil, errors.Newf("Only DBServer can be cleaned out")
+			}
+
+			return api.Plan{actions.NewAction(api.ActionTypeCleanOutMember, group, member, reason)}, nil
+		case api.ArangoTaskReplaceMemberType:
+			switch group {
+			case api.ServerGroupDBServers, api.ServerGroupAgents, api.ServerGroupCoordinators:
+			default:
+				return nil, errors.Newf("Members of the %s group cannot be replaced", group.AsRole())
+			}
+
+			return api.Plan{actions.NewAction(api.ActionTypeMarkToRemoveMember, group, member, reason)}, nil
+		}
+	}
+
+	switch t.Type {
+	case api.ArangoTaskRotateJWTType:
+		folder, err := ensureJWTFolderSupport(spec, status)
+		if err != nil {
+			return nil, err
+		}
+
+		if !folder {
+			return nil, errors.Newf("JWT rotation is not supported")
+		}
+
+		return api.Plan{actions.NewClusterAction(api.ActionTypeJWTRenew, reason)}, nil
+	case api.ArangoTaskRotateTLSCAType:
+		if !spec.TLS.IsSecure() {
+			return nil, errors.Newf("TLS is disabled")
+		}
+
+		caSecret, ok := context.ACS().CurrentClusterCache().Secret().V1().GetSimple(spec.TLS.GetCASecretName())
+		if !ok {
+			return nil, errors.Newf("CA Secret %s does not exist", spec.TLS.GetCASecretName())
+		}
+
+		if !k8sutil.IsOwner(context.GetAPIObject().AsOwner(), caSecret) {
+			return nil, errors.Newf("CA Secret %s is not owned by the deployment", spec.TLS.GetCASecretName())
+		}
+
+		return api.Plan{actions.NewClusterAction(api.ActionTypeRenewTLSCACertificate, reason)}, nil
+	case api.ArangoTaskEnableMaintenanceType:
+		if !spec.Mode.Get().HasAgents() {
+			return nil, errors.Newf("Maintenance is not supported in %s mode", spec.Mode.Get())
+		}
+
+		if features.Maintenance().Enabled() {
+			return nil, errors.Newf("Maintenance is managed by the deployment spec")
+		}
+
+		return api.Plan{actions.NewClusterAction(api.ActionTypeEnableMaintenance, reason)}, nil
+	}
+
+	return nil, errors.Newf("Unsupported task type: %s", t.Type)
+}
+
+// getArangoTasks returns ArangoTasks owned by the deployment, oldest first
+func (r *Reconciler) getArangoTasks(apiObject k8sutil.APIObject, context PlanBuilderContext) []*api.ArangoTask {
+	inspector, err := context.ACS().CurrentClusterCache().ArangoTask().V1()
+	if err != nil {
+		r.planLogger.Err(err).Debug("ArangoTasks are not available")
+		return nil
+	}
+
+	tasks := inspector.Filter(func(at *api.ArangoTask) bool {
+		return k8sutil.IsOwner(apiObject.AsOwner(), at)
+	})
+
+	sort.Slice(tasks, func(i, j int) bool {
+		if a, b := tasks[i].GetCreationTimestamp(), tasks[j].GetCreationTimestamp(); !a.Equal(&b) {
+			return a.Before(&b)
+		}
+
+		return tasks[i].GetName() < tasks[j].GetName()
+	})
+
+	return tasks
+}
+
+func arangoTaskStatusUpdateAction(task *api.ArangoTask, state api.ArangoTaskState, message string) api.Action {
+	a := actions.NewClusterAction(api.ActionTypeArangoTaskStatusUpdate, fmt.Sprintf("ArangoTask %s", task.GetName())).
+		AddParam(actionArangoTaskStatusUpdateState, string(state))
+
+	if message != "" {
+		a = a.AddParam(actionArangoTaskStatusUpdateMessage, message)
+	}
+
+	a.TaskID = task.GetUID()
+
+	return a
+}
+
+func isArangoTaskInPlan(plan api.Plan, uid types.UID) bool {
+	for _, a := range plan {
+		if a.TaskID == uid {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,124 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+func Test_ArangoTaskActions(t *testing.T) {
+	spec := api.DeploymentSpec{
+		Mode: api.NewMode(api.DeploymentModeCluster),
+	}
+
+	var status api.DeploymentStatus
+	require.NoError(t, status.Members.Add(api.MemberStatus{ID: "A1"}, api.ServerGroupAgents))
+	require.NoError(t, status.Members.Add(api.MemberStatus{ID: "D1"}, api.ServerGroupDBServers))
+
+	newTask := func(taskType api.ArangoTaskType, member string) *api.ArangoTask {
+		task := &api.ArangoTask{
+			ObjectMeta: meta.ObjectMeta{
+				Name: "task",
+				UID:  types.UID("uid"),
+			},
+			Spec: api.ArangoTaskSpec{
+				Type: taskType,
+			},
+		}
+
+		if member != "" {
+			require.NoError(t, task.Spec.Details.Set(api.ArangoTaskMemberDetails{ID: member}))
+		}
+
+		return task
+	}
+
+	r := newTestReconciler()
+
+	t.Run("Unsupported type", func(t *testing.T) {
+		_, err := r.createArangoTaskActions(spec, status, nil, newTask("Unknown", ""))
+		require.EqualError(t, err, "Unsupported task type: Unknown")
+	})
+
+	t.Run("Missing member", func(t *testing.T) {
+		_, err := r.createArangoTaskActions(spec, status, nil, newTask(api.ArangoTaskResignLeadershipType, ""))
+		require.EqualError(t, err, "Member ID is missing in task details")
+
+		_, err = r.createArangoTaskActions(spec, status, nil, newTask(api.ArangoTaskResignLeadershipType, "D2"))
+		require.EqualError(t, err, "Member D2 does not exist")
+	})
+
+	t.Run("Resign leadership", func(t *testing.T) {
+		_, err := r.createArangoTaskActions(spec, status, nil, newTask(api.ArangoTaskResignLeadershipType, "A1"))
+		require.Error(t, err)
+
+		plan, err := r.createArangoTaskActions(spec, status, nil, newTask(api.ArangoTaskResignLeadershipType, "D1"))
+		require.NoError(t, err)
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeResignLeadership, plan[0].Type)
+		require.Equal(t, "D1", plan[0].MemberID)
+	})
+
+	t.Run("Replace member", func(t *testing.T) {
+		plan, err := r.createArangoTaskActions(spec, status, nil, newTask(api.ArangoTaskReplaceMemberType, "A1"))
+		require.NoError(t, err)
+		require.Len(t, plan, 1)
+		require.Equal(t, api.ActionTypeMarkToRemoveMember, plan[0].Type)
+		require.Equal(t, api.ServerGroupAgents, plan[0].Group)
+	})
+
+	t.Run("Restart member", func(t *testing.T) {
+		plan, err := r.createArangoTaskActions(spec, status, nil, newTask(api.ArangoTaskRestartMemberType, "D1"))
+		require.NoError(t, err)
+		require.NotEmpty(t, plan.Filter(func(a api.Action) bool {
+			return a.Type == api.ActionTypeRotateMember
+		}))
+	})
+
+	t.Run("Enable maintenance in single mode", func(t *testing.T) {
+		_, err := r.createArangoTaskActions(api.DeploymentSpec{Mode: api.NewMode(api.DeploymentModeSingle)}, status, nil, newTask(api.ArangoTaskEnableMaintenanceType, ""))
+		require.Error(t, err)
+	})
+}
+
+func Test_ArangoTaskStatusUpdateAction(t *testing.T) {
+	task := &api.ArangoTask{
+		ObjectMeta: meta.ObjectMeta{
+			Name: "task",
+			UID:  types.UID("uid"),
+		},
+	}
+
+	a := arangoTaskStatusUpdateAction(task, api.ArangoTaskFailedState, "failed")
+
+	require.Equal(t, types.UID("uid"), a.TaskID)
+	require.Equal(t, "Failed", a.Params[actionArangoTaskStatusUpdateState])
+	require.Equal(t, "failed", a.Params[actionArangoTaskStatusUpdateMessage])
+
+	require.True(t, isArangoTaskInPlan(api.Plan{a}, "uid"))
+	require.False(t, isArangoTaskInPlan(api.Plan{a}, "other"))
+}
@@ -81,6 +81,9 @@ type testContext struct {
 	PVC              *core.PersistentVolumeClaim
 	PVCErr           error
 	RecordedEvent    *k8sutil.Event
+	Backup           *backupApi.ArangoBackup
+	Restores         []backupApi.ArangoRestore
+	UpdatedRestores  []backupApi.ArangoRestore
 
 	Inspector inspectorInterface.Inspector
 	state     member.StateInspector
@@ -237,9 +240,26 @@ func (c *testContext) GetName() string {
 }
 
 func (c *testContext) GetBackup(_ context.Context, backup string) (*backupApi.ArangoBackup, error) {
+	if c.Backup == nil || c.Backup.GetName() != backup {
+		panic("implement me")
+	}
+
+	return c.Backup.DeepCopy(), nil
+}
+
+func (c *testContext) GetRestore(_ context.Context, restore string) (*backupApi.ArangoRestore, error) {
 	panic("implement me")
 }
 
+func (c *testContext) ListRestores(_ context.Context) ([]backupApi.ArangoRestore, error) {
+	return c.Restores, nil
+}
+
+func (c *testContext) UpdateRestoreStatus(_ context.Context, restore *backupApi.ArangoRestore) error {
+	c.UpdatedRestores = append(c.UpdatedRestores, *restore.DeepCopy())
+	return nil
+}
+
 func (c *testContext) SecretsInterface() secretv1.Interface {
 	panic("implement me")
 }
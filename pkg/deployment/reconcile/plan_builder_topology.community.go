@@ -23,44 +23,309 @@ package reconcile
 
 import (
 	"context"
+	"math"
+	"sort"
+	"strconv"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/topology"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
+const (
+	// topologyZoneKey define zone to which member should be reassigned
+	topologyZoneKey = "zone"
+)
+
 func (r *Reconciler) createTopologyEnablementPlan(ctx context.Context, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
 	context PlanBuilderContext) api.Plan {
+	if spec.GetMode() == api.DeploymentModeSingle {
+		return nil
+	}
+
+	enabled := spec.Topology.IsEnabled()
+
+	switch {
+	case enabled && status.Topology == nil:
+		return api.Plan{actions.NewClusterAction(api.ActionTypeTopologyEnable, "Topology enabled")}
+	case !enabled && status.Topology != nil:
+		return api.Plan{actions.NewClusterAction(api.ActionTypeTopologyDisable, "Topology disabled")}
+	case enabled && (status.Topology.Size != spec.Topology.GetZones() || status.Topology.Label != spec.Topology.GetLabel()):
+		// Zones are resized in place, members keep their assignments when possible
+		return api.Plan{actions.NewClusterAction(api.ActionTypeTopologyZonesUpdate, "Topology zones changed")}
+	}
+
 	return nil
 }
+
 func (r *Reconciler) createTopologyMemberUpdatePlan(ctx context.Context, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
 	context PlanBuilderContext) api.Plan {
-	return nil
+	if !status.Topology.Enabled() {
+		return nil
+	}
+
+	var plan api.Plan
+
+	for _, e := range status.Members.AsList() {
+		if !topology.IsTopologyAwareGroup(e.Group) || status.Topology.IsTopologyOwned(e.Member.Topology) {
+			continue
+		}
+
+		plan = append(plan, actions.NewAction(api.ActionTypeTopologyMemberAssignment, e.Group, e.Member, "Member is not assigned to the zone"))
+	}
+
+	return plan
 }
 
 func (r *Reconciler) createTopologyMemberConditionPlan(ctx context.Context, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
 	context PlanBuilderContext) api.Plan {
-	return nil
+	var plan api.Plan
+
+	for _, e := range status.Members.AsList() {
+		if !topology.IsTopologyAwareGroup(e.Group) {
+			continue
+		}
+
+		c, exists := e.Member.Conditions.Get(api.ConditionTypeTopologyAware)
+
+		if !status.Topology.Enabled() {
+			if exists {
+				plan = append(plan, removeMemberConditionActionV2("Topology disabled", api.ConditionTypeTopologyAware, e.Group, e.Member.ID))
+			}
+			continue
+		}
+
+		if !status.Topology.IsTopologyOwned(e.Member.Topology) {
+			// Condition is set once member gets assigned
+			continue
+		}
+
+		aware, reason := isTopologyMemberAware(status.Topology, e.Group, e.Member)
+
+		if !exists || c.IsTrue() != aware || c.Message != reason {
+			plan = append(plan, updateMemberConditionActionV2("Topology awareness changed", api.ConditionTypeTopologyAware, e.Group, e.Member.ID, aware, "Topology Aware", reason, ""))
+		}
+	}
+
+	return plan
 }
 
 func (r *Reconciler) createTopologyMemberAdjustmentPlan(ctx context.Context, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
 	context PlanBuilderContext) api.Plan {
-	return nil
+	t := status.Topology
+	if !t.Enabled() {
+		return nil
+	}
+
+	// Only stateless members are moved between zones. Agents and DBServers keep their data in the zone
+	// and need to be replaced to be moved.
+	group := api.ServerGroupCoordinators
+
+	if t.IsTopologyEvenlyDistributed(group) {
+		return nil
+	}
+
+	from, to := t.GetMostUsedZone(group), t.GetLeastUsedZone(group)
+	if from < 0 || to < 0 || from == to {
+		return nil
+	}
+
+	members := t.Zones[from].Get(group)
+	if len(members) == 0 {
+		return nil
+	}
+
+	m, ok := status.Members.Coordinators.ElementByID(members[len(members)-1])
+	if !ok {
+		return nil
+	}
+
+	return api.Plan{
+		actions.NewAction(api.ActionTypeTopologyMemberAssignment, group, m, "Member moved to the least used zone").
+			AddParam(topologyZoneKey, strconv.Itoa(to)),
+	}
 }
 
 func (r *Reconciler) createTopologyUpdatePlan(ctx context.Context, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
 	context PlanBuilderContext) api.Plan {
+	t := status.Topology
+	if !t.Enabled() {
+		return nil
+	}
+
+	for _, e := range status.Members.AsList() {
+		if m := e.Member.Topology; t.IsTopologyOwned(m) && m.Label != "" && m.Zone < len(t.Zones) && !t.Zones[m.Zone].Labels.Contains(m.Label) {
+			return api.Plan{actions.NewClusterAction(api.ActionTypeTopologyZonesUpdate, "Zone labels changed")}
+		}
+	}
+
 	return nil
 }
 
+// resizeTopologyZones changes number of zones and the topology label without removing member assignments.
+// Members of the removed zones are moved to the remaining zones. When label is changed, physical zones are
+// learned again from the nodes of the members.
+func resizeTopologyZones(s *api.DeploymentStatus, zones int, label string, memberLabel func(m api.MemberStatus) string) (bool, error) {
+	t := s.Topology
+	if !t.Enabled() || zones <= 0 || (t.Size == zones && t.Label == label) {
+		return false, nil
+	}
+
+	if t.Label != label {
+		t.Label = label
+
+		for i := range t.Zones {
+			t.Zones[i].Labels = nil
+		}
+
+		for _, e := range s.Members.AsList() {
+			if !t.IsTopologyOwned(e.Member.Topology) {
+				continue
+			}
+
+			e.Member.Topology.Label = memberLabel(e.Member)
+			if err := s.Members.Update(e.Member, e.Group); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	for len(t.Zones) < zones {
+		t.Zones = append(t.Zones, api.TopologyStatusZone{ID: len(t.Zones)})
+	}
+
+	if len(t.Zones) > zones {
+		t.Zones = t.Zones[:zones]
+
+		for _, e := range s.Members.AsList() {
+			if m := e.Member.Topology; !t.IsTopologyOwned(m) || m.Zone < zones {
+				continue
+			}
+
+			zone := getTopologyZoneForMember(t, e.Group, e.Member.Topology.Label)
+			if zone < 0 {
+				return false, errors.Newf("Unable to find zone for member %s", e.Member.ID)
+			}
+
+			t.Zones[zone].AddMember(e.Group, e.Member.ID)
+			e.Member.Topology.Zone = zone
+
+			if err := s.Members.Update(e.Member, e.Group); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	t.Size = zones
+
+	return true, nil
+}
+
+// isTopologyMemberAware returns true if member group is evenly distributed and member is placed in the physical zone
+// which is not used by the other zones
+func isTopologyMemberAware(t *api.TopologyStatus, group api.ServerGroup, m api.MemberStatus) (bool, string) {
+	if !t.IsTopologyEvenlyDistributed(group) {
+		return false, "Topology invalid"
+	}
+
+	if label := m.Topology.Label; label != "" {
+		for i := range t.Zones {
+			if i != m.Topology.Zone && t.Zones[i].Labels.Contains(label) {
+				return false, "Zone shared with other topology zone"
+			}
+		}
+	}
+
+	return true, "Topology Aware"
+}
+
+// getTopologyZoneForMember returns zone for the member. Member which is already placed in the physical zone
+// is assigned to the zone which owns it, or to the least used zone which is not yet bound to any physical zone.
+func getTopologyZoneForMember(t *api.TopologyStatus, group api.ServerGroup, label string) int {
+	if label != "" {
+		for i := range t.Zones {
+			if t.Zones[i].Labels.Contains(label) {
+				return i
+			}
+		}
+
+		r, m := -1, math.MaxInt64
+
+		for i := range t.Zones {
+			if len(t.Zones[i].Labels) > 0 {
+				continue
+			}
+
+			if v := len(t.Zones[i].Get(group)); v < m {
+				r, m = i, v
+			}
+		}
+
+		if r >= 0 {
+			return r
+		}
+	}
+
+	return t.GetLeastUsedZone(group)
+}
+
+// topologyMissingMemberToRemoveSelector selects members which are not assigned to any zone
 func topologyMissingMemberToRemoveSelector(s *api.TopologyStatus) api.MemberToRemoveSelector {
-	return nil
+	if !s.Enabled() {
+		return nil
+	}
+
+	return func(m api.MemberStatusList) (string, error) {
+		for _, member := range m {
+			if !s.IsTopologyOwned(member.Topology) {
+				return member.ID, nil
+			}
+		}
+
+		return "", nil
+	}
 }
 
-func topologyAwarenessMemberToRemoveSelector(g api.ServerGroup, s *api.TopologyStatus) api.MemberToRemoveSelector {
-	return nil
+// topologyAwarenessMemberToRemoveSelector selects member from the most used zone.
+// Member from the zone is picked by the given selector, if it is provided and selects any of them.
+func topologyAwarenessMemberToRemoveSelector(g api.ServerGroup, s *api.TopologyStatus, within api.MemberToRemoveSelector) api.MemberToRemoveSelector {
+	if !s.Enabled() || !topology.IsTopologyAwareGroup(g) {
+		return nil
+	}
+
+	return func(m api.MemberStatusList) (string, error) {
+		zone := s.GetMostUsedZone(g)
+		if zone < 0 {
+			return "", nil
+		}
+
+		ids := append(api.List{}, s.Zones[zone].Get(g)...)
+		sort.Sort(sort.Reverse(sort.StringSlice(ids)))
+
+		candidates := make(api.MemberStatusList, 0, len(ids))
+		for _, id := range ids {
+			if member, ok := m.ElementByID(id); ok {
+				candidates = append(candidates, member)
+			}
+		}
+
+		if len(candidates) == 0 {
+			return "", nil
+		}
+
+		if within != nil {
+			if id, err := within(candidates); err != nil || id != "" {
+				return id, err
+			}
+		}
+
+		return candidates[0].ID, nil
+	}
 }
@@ -0,0 +1,217 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//go:build !enterprise
+// +build !enterprise
+
+package reconcile
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_GetTopologyZoneForMember(t *testing.T) {
+	s := api.NewTopologyStatus(&api.TopologySpec{Enabled: true, Zones: 3})
+
+	require.Equal(t, 0, getTopologyZoneForMember(s, api.ServerGroupDBServers, ""))
+
+	s.Zones[0].AddMember(api.ServerGroupDBServers, "A")
+	require.True(t, s.RegisterTopologyLabel(0, "zone-a"))
+
+	t.Run("Known label", func(t *testing.T) {
+		require.Equal(t, 0, getTopologyZoneForMember(s, api.ServerGroupDBServers, "zone-a"))
+	})
+
+	t.Run("Unknown label", func(t *testing.T) {
+		require.Equal(t, 1, getTopologyZoneForMember(s, api.ServerGroupDBServers, "zone-b"))
+	})
+
+	t.Run("Without label", func(t *testing.T) {
+		require.Equal(t, 1, getTopologyZoneForMember(s, api.ServerGroupDBServers, ""))
+	})
+
+	t.Run("All zones bound", func(t *testing.T) {
+		require.True(t, s.RegisterTopologyLabel(1, "zone-b"))
+		require.True(t, s.RegisterTopologyLabel(2, "zone-c"))
+
+		require.Equal(t, 1, getTopologyZoneForMember(s, api.ServerGroupDBServers, "zone-d"))
+	})
+}
+
+func Test_TopologyMemberToRemoveSelectors(t *testing.T) {
+	s := api.NewTopologyStatus(&api.TopologySpec{Enabled: true, Zones: 2})
+
+	owned := func(zone int) *api.TopologyMemberStatus {
+		return &api.TopologyMemberStatus{ID: s.ID, Zone: zone}
+	}
+
+	members := api.MemberStatusList{
+		{ID: "A", Topology: owned(0)},
+		{ID: "B", Topology: owned(0)},
+		{ID: "C", Topology: owned(1)},
+	}
+
+	s.Zones[0].AddMember(api.ServerGroupDBServers, "A")
+	s.Zones[0].AddMember(api.ServerGroupDBServers, "B")
+	s.Zones[1].AddMember(api.ServerGroupDBServers, "C")
+
+	t.Run("Missing", func(t *testing.T) {
+		id, err := topologyMissingMemberToRemoveSelector(s)(members)
+		require.NoError(t, err)
+		require.Empty(t, id)
+
+		id, err = topologyMissingMemberToRemoveSelector(s)(append(members, api.MemberStatus{ID: "D"}))
+		require.NoError(t, err)
+		require.Equal(t, "D", id)
+	})
+
+	t.Run("Most used zone", func(t *testing.T) {
+		id, err := topologyAwarenessMemberToRemoveSelector(api.ServerGroupDBServers, s, nil)(members)
+		require.NoError(t, err)
+		require.Equal(t, "B", id)
+		require.Equal(t, api.List{"A", "B"}, s.Zones[0].Get(api.ServerGroupDBServers))
+	})
+
+	t.Run("Most used zone with usage", func(t *testing.T) {
+		created := api.MemberStatusList{
+			{ID: "A", Phase: api.MemberPhaseCreated, Topology: owned(0)},
+			{ID: "B", Phase: api.MemberPhaseCreated, Topology: owned(0)},
+			{ID: "C", Phase: api.MemberPhaseCreated, Topology: owned(1)},
+		}
+
+		usage := dbserversUsage{
+			"A": {Shards: 1},
+			"B": {Shards: 3},
+			"C": {Shards: 0},
+		}
+
+		id, err := topologyAwarenessMemberToRemoveSelector(api.ServerGroupDBServers, s, usage.memberToRemoveSelector())(created)
+		require.NoError(t, err)
+		require.Equal(t, "A", id)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		require.Nil(t, topologyMissingMemberToRemoveSelector(nil))
+		require.Nil(t, topologyAwarenessMemberToRemoveSelector(api.ServerGroupDBServers, nil, nil))
+	})
+}
+
+func Test_ResizeTopologyZones(t *testing.T) {
+	newStatus := func(t *testing.T, zones int) *api.DeploymentStatus {
+		s := &api.DeploymentStatus{
+			Topology: api.NewTopologyStatus(&api.TopologySpec{Enabled: true, Zones: zones, Label: util.NewString("zone")}),
+		}
+
+		for id, zone := range []int{0, 1, 2} {
+			if zone >= zones {
+				continue
+			}
+
+			m := api.MemberStatus{
+				ID: string(rune('A' + id)),
+				Topology: &api.TopologyMemberStatus{
+					ID:    s.Topology.ID,
+					Zone:  zone,
+					Label: fmt.Sprintf("zone-%d", zone),
+				},
+			}
+			require.NoError(t, s.Members.Add(m, api.ServerGroupDBServers))
+			s.Topology.Zones[zone].AddMember(api.ServerGroupDBServers, m.ID)
+			require.True(t, s.Topology.RegisterTopologyLabel(zone, m.Topology.Label))
+		}
+
+		return s
+	}
+
+	memberLabel := func(m api.MemberStatus) string {
+		return "new-" + m.ID
+	}
+
+	t.Run("Not changed", func(t *testing.T) {
+		s := newStatus(t, 3)
+
+		changed, err := resizeTopologyZones(s, 3, "zone", memberLabel)
+		require.NoError(t, err)
+		require.False(t, changed)
+	})
+
+	t.Run("Zones added", func(t *testing.T) {
+		s := newStatus(t, 2)
+		id := s.Topology.ID
+
+		changed, err := resizeTopologyZones(s, 3, "zone", memberLabel)
+		require.NoError(t, err)
+		require.True(t, changed)
+
+		require.Equal(t, id, s.Topology.ID)
+		require.Equal(t, 3, s.Topology.Size)
+		require.Len(t, s.Topology.Zones, 3)
+		require.Equal(t, 2, s.Topology.Zones[2].ID)
+		require.Equal(t, api.List{"zone-0"}, s.Topology.Zones[0].Labels)
+
+		for _, m := range s.Members.DBServers {
+			require.True(t, s.Topology.IsTopologyOwned(m.Topology))
+		}
+	})
+
+	t.Run("Zones removed", func(t *testing.T) {
+		s := newStatus(t, 3)
+
+		changed, err := resizeTopologyZones(s, 2, "zone", memberLabel)
+		require.NoError(t, err)
+		require.True(t, changed)
+
+		require.Equal(t, 2, s.Topology.Size)
+		require.Len(t, s.Topology.Zones, 2)
+
+		// Members of the remaining zones are not moved
+		m, _, ok := s.Members.ElementByID("A")
+		require.True(t, ok)
+		require.Equal(t, 0, m.Topology.Zone)
+
+		m, _, ok = s.Members.ElementByID("C")
+		require.True(t, ok)
+		require.True(t, s.Topology.IsTopologyOwned(m.Topology))
+		require.Less(t, m.Topology.Zone, 2)
+		require.Contains(t, s.Topology.Zones[m.Topology.Zone].Get(api.ServerGroupDBServers), "C")
+	})
+
+	t.Run("Label changed", func(t *testing.T) {
+		s := newStatus(t, 3)
+
+		changed, err := resizeTopologyZones(s, 3, "other", memberLabel)
+		require.NoError(t, err)
+		require.True(t, changed)
+
+		require.Equal(t, "other", s.Topology.Label)
+		for i := range s.Topology.Zones {
+			require.Empty(t, s.Topology.Zones[i].Labels)
+		}
+
+		m, _, ok := s.Members.ElementByID("B")
+		require.True(t, ok)
+		require.Equal(t, 1, m.Topology.Zone)
+		require.Equal(t, "new-B", m.Topology.Label)
+	})
+}
@@ -0,0 +1,246 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"fmt"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+)
+
+func upgradeCanaryUpdateAction(image string, phase api.DeploymentUpgradeCanaryPhase, message string) api.Action {
+	return actions.NewClusterAction(api.ActionTypeUpgradeCanaryUpdate, message).
+		SetImage(image).
+		AddParam(actionUpgradeCanaryUpdatePhase, string(phase)).
+		AddParam(actionUpgradeCanaryUpdateMessage, message)
+}
+
+func upgradeCanaryFailedPlan(image, message string) api.Plan {
+	return api.Plan{
+		upgradeCanaryUpdateAction(image, api.DeploymentUpgradeCanaryPhaseFailed, message),
+		updateConditionActionV2("Canary upgrade failed", api.ConditionTypeUpgradeFailed, true, "Canary upgrade failed", message, ""),
+	}
+}
+
+func upgradeCanaryMemberConditionAction(m api.DeploymentStatusMemberElement, reason string, value bool) api.Action {
+	v := ""
+	if value {
+		v = "T"
+	}
+
+	return actions.NewAction(api.ActionTypeSetMemberCondition, m.Group, m.Member, reason).AddParam(api.ConditionTypeUpgradeCanary.String(), v)
+}
+
+// createUpgradeCanaryPlan creates plan for the canary upgrade. Returns true when canary succeeded and remaining members can be upgraded.
+func (r *Reconciler) createUpgradeCanaryPlan(spec api.DeploymentSpec, status api.DeploymentStatus, context PlanBuilderContext, decision updateUpgradeDecisionMap) (api.Plan, bool) {
+	for _, d := range decision {
+		if d.upgrade && d.upgradeDecision.Hold {
+			// Holding upgrade till image is discovered
+			return nil, false
+		}
+	}
+
+	image := spec.GetImage()
+	canarySpec := spec.Upgrade.Get().Canary
+
+	canaries := status.Members.AsList().Filter(func(a api.DeploymentStatusMemberElement) bool {
+		return a.Member.Conditions.IsTrue(api.ConditionTypeUpgradeCanary)
+	})
+
+	switch phase := status.UpgradeCanary.GetPhase(image); phase {
+	case "":
+		plan := api.Plan{upgradeCanaryUpdateAction(image, api.DeploymentUpgradeCanaryPhaseUpgrading, "Canary upgrade started")}
+
+		if status.Conditions.IsTrue(api.ConditionTypeUpgradeFailed) {
+			plan = append(plan, removeConditionActionV2("Canary upgrade started", api.ConditionTypeUpgradeFailed))
+		}
+
+		// Clean canary members of the previous upgrade
+		for _, m := range canaries {
+			plan = append(plan, upgradeCanaryMemberConditionAction(m, "Previous canary upgrade finished", false))
+		}
+
+		for _, m := range selectUpgradeCanaryMembers(status, decision) {
+			plan = append(plan, upgradeCanaryMemberConditionAction(m, "Member selected as upgrade canary", true))
+		}
+
+		return plan, false
+	case api.DeploymentUpgradeCanaryPhaseUpgrading:
+		if message, failed := upgradeCanaryFailed(canarySpec, canaries, nil); failed {
+			return upgradeCanaryFailedPlan(image, message), false
+		}
+
+		for _, m := range canaries {
+			d := decision[m.Member.ID]
+			if !d.upgrade || !d.upgradeDecision.UpgradeNeeded {
+				continue
+			}
+
+			if !d.updateAllowed && !d.unsafeUpdateAllowed {
+				r.planLogger.Str("member", m.Member.ID).Str("Reason", d.updateMessage).Info("Canary member needs upgrade but cluster is not ready.")
+				return nil, false
+			}
+
+			return r.createUpgradeMemberPlan(m.Member, m.Group, "Canary version upgrade", spec, status, !d.upgradeDecision.AutoUpgradeNeeded), false
+		}
+
+		return api.Plan{upgradeCanaryUpdateAction(image, api.DeploymentUpgradeCanaryPhaseSoaking,
+			fmt.Sprintf("Canary members upgraded, observing them for %s", canarySpec.GetSoakPeriod()))}, false
+	case api.DeploymentUpgradeCanaryPhaseSoaking:
+		since := status.UpgradeCanary.LastTransitionTime.Time
+
+		if message, failed := upgradeCanaryFailed(canarySpec, canaries, &since); failed {
+			return upgradeCanaryFailedPlan(image, message), false
+		}
+
+		if time.Since(since) < canarySpec.GetSoakPeriod() {
+			// Soak period in progress
+			return nil, false
+		}
+
+		if message, healthy := upgradeCanaryHealthy(spec, context, canaries); !healthy {
+			return upgradeCanaryFailedPlan(image, message), false
+		}
+
+		plan := api.Plan{upgradeCanaryUpdateAction(image, api.DeploymentUpgradeCanaryPhaseSucceeded, "Canary members are healthy")}
+
+		for _, m := range canaries {
+			plan = append(plan, upgradeCanaryMemberConditionAction(m, "Canary upgrade succeeded", false))
+		}
+
+		return plan, false
+	case api.DeploymentUpgradeCanaryPhaseSucceeded:
+		return nil, true
+	case api.DeploymentUpgradeCanaryPhaseFailed:
+		if canarySpec.GetRollback() {
+			if target, ok := currentImageInfo(spec, status.Images); ok && isPatchLevelUpgrade(status.UpgradeCanary.FromImage, target) {
+				return r.createUpgradeCanaryRollbackPlan(spec, status, canaries), false
+			}
+		}
+
+		// Upgrade is halted till the image is changed
+		return nil, false
+	default:
+		// Upgrade is halted till the image is changed
+		return nil, false
+	}
+}
+
+// selectUpgradeCanaryMembers returns first member of each group which needs to be upgraded
+func selectUpgradeCanaryMembers(status api.DeploymentStatus, decision updateUpgradeDecisionMap) api.DeploymentStatusMemberElements {
+	var members api.DeploymentStatusMemberElements
+
+	for _, group := range api.AllServerGroups {
+		for _, m := range status.Members.AsListInGroup(group) {
+			if d := decision[m.Member.ID]; d.upgrade && d.upgradeDecision.UpgradeNeeded {
+				members = append(members, m)
+				break
+			}
+		}
+	}
+
+	return members
+}
+
+// upgradeCanaryFailed checks if any canary member failed. Restarts are counted only if soak period started.
+func upgradeCanaryFailed(spec *api.DeploymentUpgradeCanarySpec, canaries api.DeploymentStatusMemberElements, since *time.Time) (string, bool) {
+	for _, m := range canaries {
+		if m.Member.Phase == api.MemberPhaseFailed {
+			return fmt.Sprintf("Canary member %s failed", m.Member.ID), true
+		}
+
+		if m.Member.Conditions.IsTrue(api.ConditionTypeUpgradeFailed) {
+			return fmt.Sprintf("Upgrade of the canary member %s failed", m.Member.ID), true
+		}
+
+		if since != nil {
+			if restarts := m.Member.RecentTerminationsSince(*since); restarts > spec.GetMaxRestarts() {
+				return fmt.Sprintf("Canary member %s restarted %d times during soak period", m.Member.ID, restarts), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// upgradeCanaryHealthy checks if canary members are ready and serving and agency is healthy
+func upgradeCanaryHealthy(spec api.DeploymentSpec, context PlanBuilderContext, canaries api.DeploymentStatusMemberElements) (string, bool) {
+	for _, m := range canaries {
+		if !m.Member.Conditions.IsTrue(api.ConditionTypeReady) {
+			return fmt.Sprintf("Canary member %s is not ready", m.Member.ID), false
+		}
+
+		if !m.Member.Conditions.IsTrue(api.ConditionTypeServing) {
+			return fmt.Sprintf("Canary member %s is not serving", m.Member.ID), false
+		}
+	}
+
+	if spec.GetMode().HasAgents() {
+		health, ok := context.GetAgencyHealth()
+		if !ok {
+			return "Unable to get agency health", false
+		}
+
+		if err := health.Healthy(); err != nil {
+			return fmt.Sprintf("Agency is not healthy: %s", err.Error()), false
+		}
+	}
+
+	return "", true
+}
+
+// isPatchLevelUpgrade returns true if upgrade changes only patch version
+func isPatchLevelUpgrade(from *api.ImageInfo, to api.ImageInfo) bool {
+	if from == nil {
+		return false
+	}
+
+	return from.Enterprise == to.Enterprise &&
+		from.ArangoDBVersion.Major() == to.ArangoDBVersion.Major() &&
+		from.ArangoDBVersion.Minor() == to.ArangoDBVersion.Minor()
+}
+
+// createUpgradeCanaryRollbackPlan rotates canary members back to the image used before the upgrade
+func (r *Reconciler) createUpgradeCanaryRollbackPlan(spec api.DeploymentSpec, status api.DeploymentStatus, canaries api.DeploymentStatusMemberElements) api.Plan {
+	from := status.UpgradeCanary.FromImage
+	reason := fmt.Sprintf("Canary rollback to %s", from.Image)
+
+	var plan api.Plan
+
+	if status.CurrentImage == nil || status.CurrentImage.Image != from.Image {
+		plan = append(plan, actions.NewClusterAction(api.ActionTypeSetCurrentImage, reason).SetImage(from.Image))
+	}
+
+	for _, m := range canaries {
+		if m.Member.Image != nil && m.Member.Image.Image == from.Image {
+			continue
+		}
+
+		r.planLogger.Str("member", m.Member.ID).Str("image", from.Image).Info("Rolling back canary member")
+
+		plan = append(plan, createRotateMemberPlanWithAction(m.Member, m.Group, api.ActionTypeRotateMember, spec, reason).
+			Before(actions.NewAction(api.ActionTypeSetMemberCurrentImage, m.Group, m.Member, reason).SetImage(from.Image))...)
+	}
+
+	return append(plan, upgradeCanaryUpdateAction(status.UpgradeCanary.Image, api.DeploymentUpgradeCanaryPhaseRolledBack, reason))
+}
@@ -0,0 +1,233 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/go-driver"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_UpgradeCanary(t *testing.T) {
+	from := api.ImageInfo{Image: "a", ImageID: "aid", ArangoDBVersion: "3.9.1"}
+	to := api.ImageInfo{Image: "b", ImageID: "bid", ArangoDBVersion: "3.9.2"}
+
+	strategy := api.DeploymentUpgradeStrategyCanary
+
+	newSpec := func(mode api.DeploymentMode) api.DeploymentSpec {
+		return api.DeploymentSpec{
+			Mode:  api.NewMode(mode),
+			Image: util.NewString(to.Image),
+			Upgrade: &api.DeploymentUpgradeSpec{
+				Strategy: &strategy,
+				Canary: &api.DeploymentUpgradeCanarySpec{
+					SoakPeriod: &meta.Duration{Duration: time.Minute},
+					Rollback:   util.NewBool(true),
+				},
+			},
+		}
+	}
+
+	newMember := func(id string, image api.ImageInfo, canary bool) api.MemberStatus {
+		m := api.MemberStatus{
+			ID:    id,
+			Phase: api.MemberPhaseCreated,
+			Image: image.DeepCopy(),
+		}
+		m.Conditions.Update(api.ConditionTypeReady, true, "", "")
+		m.Conditions.Update(api.ConditionTypeServing, true, "", "")
+		if canary {
+			m.Conditions.Update(api.ConditionTypeUpgradeCanary, true, "", "")
+		}
+		return m
+	}
+
+	newStatus := func(phase api.DeploymentUpgradeCanaryPhase, since time.Time, members ...api.MemberStatus) api.DeploymentStatus {
+		s := api.DeploymentStatus{
+			Images:       api.ImageInfoList{from, to},
+			CurrentImage: from.DeepCopy(),
+		}
+		if phase != "" {
+			s.UpgradeCanary = &api.DeploymentUpgradeCanaryStatus{
+				Image:              to.Image,
+				FromImage:          from.DeepCopy(),
+				Phase:              phase,
+				LastTransitionTime: meta.NewTime(since),
+			}
+		}
+		for _, m := range members {
+			group := api.ServerGroupDBServers
+			if m.ID == "single" {
+				group = api.ServerGroupSingle
+			} else if m.ID[0] == 'C' {
+				group = api.ServerGroupCoordinators
+			}
+			require.NoError(t, s.Members.Add(m, group))
+		}
+		return s
+	}
+
+	newDecision := func(status api.DeploymentStatus) updateUpgradeDecisionMap {
+		d := updateUpgradeDecisionMap{}
+		for _, m := range status.Members.AsList() {
+			d[m.Member.ID] = updateUpgradeDecision{
+				upgrade: m.Member.Image.Image != to.Image,
+				upgradeDecision: upgradeDecision{
+					UpgradeNeeded:  m.Member.Image.Image != to.Image,
+					UpgradeAllowed: true,
+				},
+				updateAllowed: true,
+			}
+		}
+		return d
+	}
+
+	r := newTestReconciler()
+
+	t.Run("Start", func(t *testing.T) {
+		// Arrange
+		status := newStatus("", time.Now(), newMember("D1", from, false), newMember("D2", from, false), newMember("C1", from, false), newMember("C2", from, false))
+
+		// Act
+		plan, done := r.createUpgradeCanaryPlan(newSpec(api.DeploymentModeCluster), status, nil, newDecision(status))
+
+		// Assert
+		require.False(t, done)
+		require.Len(t, plan, 3)
+		require.Equal(t, api.ActionTypeUpgradeCanaryUpdate, plan[0].Type)
+		require.Equal(t, string(api.DeploymentUpgradeCanaryPhaseUpgrading), plan[0].Params[actionUpgradeCanaryUpdatePhase])
+		require.Equal(t, api.ActionTypeSetMemberCondition, plan[1].Type)
+		require.Equal(t, "D1", plan[1].MemberID)
+		require.Equal(t, api.ActionTypeSetMemberCondition, plan[2].Type)
+		require.Equal(t, "C1", plan[2].MemberID)
+	})
+
+	t.Run("Upgrade canary member", func(t *testing.T) {
+		// Arrange
+		status := newStatus(api.DeploymentUpgradeCanaryPhaseUpgrading, time.Now(), newMember("D1", from, true), newMember("D2", from, false))
+
+		// Act
+		plan, done := r.createUpgradeCanaryPlan(newSpec(api.DeploymentModeCluster), status, nil, newDecision(status))
+
+		// Assert
+		require.False(t, done)
+		require.NotEmpty(t, plan)
+		for _, a := range plan {
+			require.NotEqual(t, "D2", a.MemberID)
+		}
+	})
+
+	t.Run("Start soak", func(t *testing.T) {
+		// Arrange
+		status := newStatus(api.DeploymentUpgradeCanaryPhaseUpgrading, time.Now(), newMember("D1", to, true), newMember("D2", from, false))
+
+		// Act
+		plan, done := r.createUpgradeCanaryPlan(newSpec(api.DeploymentModeCluster), status, nil, newDecision(status))
+
+		// Assert
+		require.False(t, done)
+		require.Len(t, plan, 1)
+		require.Equal(t, string(api.DeploymentUpgradeCanaryPhaseSoaking), plan[0].Params[actionUpgradeCanaryUpdatePhase])
+	})
+
+	t.Run("Soak in progress", func(t *testing.T) {
+		// Arrange
+		status := newStatus(api.DeploymentUpgradeCanaryPhaseSoaking, time.Now(), newMember("D1", to, true), newMember("D2", from, false))
+
+		// Act
+		plan, done := r.createUpgradeCanaryPlan(newSpec(api.DeploymentModeCluster), status, nil, newDecision(status))
+
+		// Assert
+		require.False(t, done)
+		require.Empty(t, plan)
+	})
+
+	t.Run("Restarted during soak", func(t *testing.T) {
+		// Arrange
+		member := newMember("D1", to, true)
+		member.RecentTerminations = []meta.Time{meta.Now()}
+		status := newStatus(api.DeploymentUpgradeCanaryPhaseSoaking, time.Now().Add(-time.Second), member, newMember("D2", from, false))
+
+		// Act
+		plan, done := r.createUpgradeCanaryPlan(newSpec(api.DeploymentModeCluster), status, nil, newDecision(status))
+
+		// Assert
+		require.False(t, done)
+		require.Len(t, plan, 2)
+		require.Equal(t, string(api.DeploymentUpgradeCanaryPhaseFailed), plan[0].Params[actionUpgradeCanaryUpdatePhase])
+		require.Equal(t, api.ActionTypeSetConditionV2, plan[1].Type)
+	})
+
+	t.Run("Soak finished", func(t *testing.T) {
+		// Arrange
+		status := newStatus(api.DeploymentUpgradeCanaryPhaseSoaking, time.Now().Add(-2*time.Minute), newMember("single", to, true))
+
+		// Act
+		plan, done := r.createUpgradeCanaryPlan(newSpec(api.DeploymentModeSingle), status, nil, newDecision(status))
+
+		// Assert
+		require.False(t, done)
+		require.Len(t, plan, 2)
+		require.Equal(t, string(api.DeploymentUpgradeCanaryPhaseSucceeded), plan[0].Params[actionUpgradeCanaryUpdatePhase])
+		require.Equal(t, api.ActionTypeSetMemberCondition, plan[1].Type)
+	})
+
+	t.Run("Succeeded", func(t *testing.T) {
+		// Arrange
+		status := newStatus(api.DeploymentUpgradeCanaryPhaseSucceeded, time.Now(), newMember("D1", to, false), newMember("D2", from, false))
+
+		// Act
+		plan, done := r.createUpgradeCanaryPlan(newSpec(api.DeploymentModeCluster), status, nil, newDecision(status))
+
+		// Assert
+		require.True(t, done)
+		require.Empty(t, plan)
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		// Arrange
+		status := newStatus(api.DeploymentUpgradeCanaryPhaseFailed, time.Now(), newMember("D1", to, true), newMember("D2", from, false))
+		status.CurrentImage = to.DeepCopy()
+
+		// Act
+		plan, done := r.createUpgradeCanaryPlan(newSpec(api.DeploymentModeCluster), status, nil, newDecision(status))
+
+		// Assert
+		require.False(t, done)
+		require.Equal(t, api.ActionTypeSetCurrentImage, plan[0].Type)
+		require.Equal(t, from.Image, plan[0].Image)
+		require.Equal(t, api.ActionTypeSetMemberCurrentImage, plan[1].Type)
+		require.Equal(t, "D1", plan[1].MemberID)
+		require.Equal(t, string(api.DeploymentUpgradeCanaryPhaseRolledBack), plan[len(plan)-1].Params[actionUpgradeCanaryUpdatePhase])
+	})
+
+	t.Run("Rollback not allowed for minor upgrade", func(t *testing.T) {
+		require.False(t, isPatchLevelUpgrade(&api.ImageInfo{ArangoDBVersion: "3.8.5"}, api.ImageInfo{ArangoDBVersion: driver.Version("3.9.0")}))
+		require.True(t, isPatchLevelUpgrade(&from, to))
+	})
+}
@@ -0,0 +1,160 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/client"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+const (
+	// volumeAutoGrowCheckInterval defines how often usage of the member volume is checked
+	volumeAutoGrowCheckInterval = time.Minute
+)
+
+// volumeAutoGrowChecks keeps time of the last volume usage check per member
+type volumeAutoGrowChecks struct {
+	lock sync.Mutex
+
+	checks map[string]time.Time
+}
+
+// required returns true if usage of the member volume needs to be checked and saves time of the check
+func (v *volumeAutoGrowChecks) required(id string, now time.Time) bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if v.checks == nil {
+		v.checks = map[string]time.Time{}
+	}
+
+	if last, ok := v.checks[id]; ok && now.Sub(last) < volumeAutoGrowCheckInterval {
+		return false
+	}
+
+	v.checks[id] = now
+	return true
+}
+
+// createVolumeAutoGrowPlan creates plan to grow volumes of the members which usage exceeds the threshold
+func (r *Reconciler) createVolumeAutoGrowPlan(ctx context.Context, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	context PlanBuilderContext) api.Plan {
+	for _, member := range status.Members.AsList() {
+		groupSpec := spec.GetServerGroupSpec(member.Group)
+		autoGrow := groupSpec.GetVolumeAutoGrow()
+
+		if !autoGrow.IsEnabled() {
+			continue
+		}
+
+		if member.Member.Phase != api.MemberPhaseCreated || member.Member.PersistentVolumeClaimName == "" {
+			continue
+		}
+
+		if !member.Member.Conditions.IsTrue(api.ConditionTypeReady) || member.Member.Conditions.IsTrue(api.ConditionTypePVCResizePending) {
+			continue
+		}
+
+		cache, ok := context.ACS().ClusterCache(member.Member.ClusterID)
+		if !ok {
+			continue
+		}
+
+		pvc, ok := cache.PersistentVolumeClaim().V1().GetSimple(member.Member.PersistentVolumeClaimName)
+		if !ok {
+			continue
+		}
+
+		volumeSize, ok := pvc.Spec.Resources.Requests[core.ResourceStorage]
+		if !ok {
+			continue
+		}
+
+		if capacity, ok := pvc.Status.Capacity[core.ResourceStorage]; !ok || capacity.Cmp(volumeSize) < 0 {
+			// Resize is still in progress
+			continue
+		}
+
+		if !r.volumeAutoGrowChecks.required(member.Member.ID, time.Now()) {
+			continue
+		}
+
+		stats, err := r.getMemberEngineStats(ctx, context, member.Member.ID)
+		if err != nil {
+			r.planLogger.Err(err).Str("member", member.Member.ID).Debug("Unable to get engine statistics")
+			continue
+		}
+
+		if !isVolumeAutoGrowRequired(autoGrow, stats) {
+			continue
+		}
+
+		size, ok := autoGrow.GetNextSize(volumeSize)
+		if !ok {
+			r.planLogger.
+				Str("role", member.Group.AsRole()).
+				Str("id", member.Member.ID).
+				Str("size", volumeSize.String()).
+				Warn("Volume usage exceeds threshold, but maximum size is reached")
+			continue
+		}
+
+		r.planLogger.
+			Str("role", member.Group.AsRole()).
+			Str("id", member.Member.ID).
+			Str("from", volumeSize.String()).
+			Str("to", size.String()).
+			Info("Volume usage exceeds threshold, growing volume")
+
+		return withPVCResizeSize(r.pvcResizePlan(member.Group, member.Member, groupSpec.VolumeResizeMode.Get()), size)
+	}
+
+	return nil
+}
+
+// isVolumeAutoGrowRequired returns true if usage of the volume reaches the threshold
+func isVolumeAutoGrowRequired(autoGrow *api.ServerGroupVolumeAutoGrowSpec, stats client.EngineStats) bool {
+	if stats.TotalDiskSpace <= 0 {
+		return false
+	}
+
+	return stats.UsedDiskSpace()*100 >= stats.TotalDiskSpace*int64(autoGrow.GetThresholdPercent())
+}
+
+// withPVCResizeSize sets size to which PVC needs to be grown on the PVCResize actions
+func withPVCResizeSize(plan api.Plan, size resource.Quantity) api.Plan {
+	for i := range plan {
+		if plan[i].Type == api.ActionTypePVCResize {
+			plan[i] = plan[i].AddParam(actionPVCResizeSize, size.String())
+		}
+	}
+
+	return plan
+}
@@ -0,0 +1,132 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/client"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_IsVolumeAutoGrowRequired(t *testing.T) {
+	autoGrow := &api.ServerGroupVolumeAutoGrowSpec{
+		ThresholdPercent: util.NewInt(80),
+	}
+
+	require.False(t, isVolumeAutoGrowRequired(autoGrow, client.EngineStats{}))
+	require.False(t, isVolumeAutoGrowRequired(autoGrow, client.EngineStats{TotalDiskSpace: 100, FreeDiskSpace: 21}))
+	require.True(t, isVolumeAutoGrowRequired(autoGrow, client.EngineStats{TotalDiskSpace: 100, FreeDiskSpace: 20}))
+	require.True(t, isVolumeAutoGrowRequired(autoGrow, client.EngineStats{TotalDiskSpace: 100, FreeDiskSpace: 0}))
+}
+
+func Test_WithPVCResizeSize(t *testing.T) {
+	plan := api.Plan{
+		actions.NewClusterAction(api.ActionTypeKillMemberPod),
+		actions.NewClusterAction(api.ActionTypePVCResize),
+	}
+
+	plan = withPVCResizeSize(plan, resource.MustParse("20Gi"))
+
+	_, ok := plan[0].GetParam(actionPVCResizeSize)
+	require.False(t, ok)
+
+	size, ok := plan[1].GetParam(actionPVCResizeSize)
+	require.True(t, ok)
+	require.Equal(t, "20Gi", size)
+}
+
+func Test_VolumeAutoGrowChecks(t *testing.T) {
+	var checks volumeAutoGrowChecks
+
+	now := time.Now()
+
+	require.True(t, checks.required("A", now))
+	require.False(t, checks.required("A", now.Add(volumeAutoGrowCheckInterval/2)))
+	require.True(t, checks.required("B", now.Add(volumeAutoGrowCheckInterval/2)))
+	require.True(t, checks.required("A", now.Add(volumeAutoGrowCheckInterval)))
+}
+
+func Test_ShouldVolumeResize_AutoGrown(t *testing.T) {
+	newPVC := func(size string, annotations map[string]string) *core.PersistentVolumeClaim {
+		return &core.PersistentVolumeClaim{
+			ObjectMeta: meta.ObjectMeta{
+				Annotations: annotations,
+			},
+			Spec: core.PersistentVolumeClaimSpec{
+				Resources: core.ResourceRequirements{
+					Requests: core.ResourceList{
+						core.ResourceStorage: resource.MustParse(size),
+					},
+				},
+			},
+		}
+	}
+
+	grown := map[string]string{
+		deployment.ArangoDeploymentPVCAutoGrownAnnotation: "20Gi",
+	}
+
+	groupSpec := api.ServerGroupSpec{
+		Resources: core.ResourceRequirements{
+			Requests: core.ResourceList{
+				core.ResourceStorage: resource.MustParse("10Gi"),
+			},
+		},
+	}
+
+	t.Run("Auto grown with autogrow disabled", func(t *testing.T) {
+		resize, _, _ := shouldVolumeResize(groupSpec, newPVC("20Gi", grown))
+		require.False(t, resize)
+	})
+
+	t.Run("Auto grown above lowered maxSize", func(t *testing.T) {
+		maxSize := resource.MustParse("15Gi")
+		spec := groupSpec
+		spec.VolumeAutoGrow = &api.ServerGroupVolumeAutoGrowSpec{
+			MaxSize: &maxSize,
+		}
+
+		resize, _, _ := shouldVolumeResize(spec, newPVC("20Gi", grown))
+		require.False(t, resize)
+	})
+
+	t.Run("Larger than auto grown size", func(t *testing.T) {
+		resize, volumeSize, requestedSize := shouldVolumeResize(groupSpec, newPVC("30Gi", grown))
+		require.True(t, resize)
+		require.Equal(t, "30Gi", volumeSize.String())
+		require.Equal(t, "10Gi", requestedSize.String())
+	})
+
+	t.Run("Not auto grown", func(t *testing.T) {
+		resize, _, _ := shouldVolumeResize(groupSpec, newPVC("20Gi", nil))
+		require.True(t, resize)
+	})
+}
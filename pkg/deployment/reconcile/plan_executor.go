@@ -186,12 +186,19 @@ func (d *Reconciler) executePlanStatus(ctx context.Context, pg planner) (bool, b
 		return false, false, nil
 	}
 
-	newPlan, callAgain, callInLoop, err := d.executePlan(ctx, plan, pg)
+	newPlan, history, callAgain, callInLoop, err := d.executePlan(ctx, plan, pg)
 
 	// Refresh current status
 	loopStatus = d.context.GetStatus()
 
-	if pg.Set(&loopStatus, newPlan) {
+	changed := pg.Set(&loopStatus, newPlan)
+
+	if len(history) > 0 {
+		loopStatus.PlanHistory = loopStatus.PlanHistory.Append(history...)
+		changed = true
+	}
+
+	if changed {
 		d.planLogger.Info("Updating plan")
 		if err := d.context.UpdateStatus(ctx, loopStatus); err != nil {
 			d.planLogger.Err(err).Debug("Failed to update CR status")
@@ -206,30 +213,36 @@ func (d *Reconciler) executePlanStatus(ctx context.Context, pg planner) (bool, b
 	return callAgain, callInLoop, nil
 }
 
-func (d *Reconciler) executePlan(ctx context.Context, statusPlan api.Plan, pg planner) (newPlan api.Plan, callAgain, callInLoop bool, err error) {
+func (d *Reconciler) executePlan(ctx context.Context, statusPlan api.Plan, pg planner) (newPlan api.Plan, history api.PlanHistory, callAgain, callInLoop bool, err error) {
 	plan := statusPlan.DeepCopy()
 
 	for {
 		if len(plan) == 0 {
-			return nil, false, false, nil
+			return nil, history, false, false, nil
 		}
 
 		// Take first action
 		planAction := plan[0]
 
+		if !planAction.IsStarted() && d.isPaused() {
+			// Reconciliation is paused, started actions are finished but new ones are not started
+			return plan, history, false, false, nil
+		}
+
 		action, actionContext := d.createAction(planAction)
 
 		done, abort, recall, retry, err := d.executeAction(ctx, planAction, action)
 		if err != nil {
 			if retry {
-				return plan, true, false, nil
+				return plan, history, true, false, nil
 			}
 			// The Plan will be cleaned up, so no actions will be in the queue.
 			actionsCurrentPlan.WithLabelValues(d.context.GetName(), planAction.Group.AsRole(), planAction.MemberID,
 				planAction.Type.String(), pg.Type()).Set(0.0)
 
 			actionsFailedMetrics.WithLabelValues(d.context.GetName(), planAction.Type.String(), pg.Type()).Inc()
-			return nil, false, false, errors.WithStack(err)
+			history = append(history, api.NewPlanHistoryEntry(planAction, pg.Type(), api.PlanHistoryResultFailed, err))
+			return nil, history, false, false, errors.WithStack(err)
 		}
 
 		if abort {
@@ -238,7 +251,12 @@ func (d *Reconciler) executePlan(ctx context.Context, statusPlan api.Plan, pg pl
 				planAction.Type.String(), pg.Type()).Set(0.0)
 
 			actionsFailedMetrics.WithLabelValues(d.context.GetName(), planAction.Type.String(), pg.Type()).Inc()
-			return nil, true, false, nil
+			if isActionTimedOut(d.context.GetSpec(), planAction) {
+				history = append(history, api.NewPlanHistoryEntry(planAction, pg.Type(), api.PlanHistoryResultTimeout, nil))
+			} else {
+				history = append(history, api.NewPlanHistoryEntry(planAction, pg.Type(), api.PlanHistoryResultAborted, nil))
+			}
+			return nil, history, true, false, nil
 		}
 
 		if done {
@@ -250,6 +268,7 @@ func (d *Reconciler) executePlan(ctx context.Context, statusPlan api.Plan, pg pl
 			}
 
 			actionsSucceededMetrics.WithLabelValues(d.context.GetName(), planAction.Type.String(), pg.Type()).Inc()
+			history = append(history, api.NewPlanHistoryEntry(planAction, pg.Type(), api.PlanHistoryResultSucceeded, nil))
 			if len(plan) > 1 {
 				plan = plan[1:]
 				if plan[0].MemberID == api.MemberIDPreviousAction {
@@ -269,19 +288,19 @@ func (d *Reconciler) executePlan(ctx context.Context, statusPlan api.Plan, pg pl
 					d.planLogger.Info("Reloading cached status")
 					if err := c.Refresh(ctx); err != nil {
 						d.planLogger.Err(err).Warn("Unable to reload cached status")
-						return plan, recall, false, nil
+						return plan, history, recall, false, nil
 					}
 				}
 			}
 
 			if newPlan, changed := getActionPlanAppender(action, plan); changed {
 				// Our actions have been added to the end of plan
-				return newPlan, false, true, nil
+				return newPlan, history, false, true, nil
 			}
 
 			if err := getActionPost(action, ctx); err != nil {
 				d.planLogger.Err(err).Error("Post action failed")
-				return nil, false, false, errors.WithStack(err)
+				return nil, history, false, false, errors.WithStack(err)
 			}
 		} else {
 			if !plan[0].IsStarted() {
@@ -295,7 +314,7 @@ func (d *Reconciler) executePlan(ctx context.Context, statusPlan api.Plan, pg pl
 
 			plan[0].Locals.Merge(actionContext.CurrentLocals())
 
-			return plan, recall, false, nil
+			return plan, history, recall, false, nil
 		}
 	}
 }
@@ -353,7 +372,7 @@ func (d *Reconciler) executeAction(ctx context.Context, planAction api.Action, a
 		log.Warn("Action aborted. Removing the entire plan")
 		d.context.CreateEvent(k8sutil.NewPlanAbortedEvent(d.context.GetAPIObject(), string(planAction.Type), planAction.MemberID, planAction.Group.AsRole()))
 		return false, true, false, false, nil
-	} else if time.Now().After(planAction.CreationTime.Add(GetActionTimeout(d.context.GetSpec(), planAction.Type))) {
+	} else if isActionTimedOut(d.context.GetSpec(), planAction) {
 		log.Warn("Action not finished in time. Removing the entire plan")
 		d.context.CreateEvent(k8sutil.NewPlanTimeoutEvent(d.context.GetAPIObject(), string(planAction.Type), planAction.MemberID, planAction.Group.AsRole()))
 		return false, true, false, false, nil
@@ -363,6 +382,11 @@ func (d *Reconciler) executeAction(ctx context.Context, planAction api.Action, a
 	return false, false, true, false, nil
 }
 
+// isActionTimedOut returns true if action is not finished within its timeout
+func isActionTimedOut(spec api.DeploymentSpec, action api.Action) bool {
+	return time.Now().After(action.CreationTime.Add(GetActionTimeout(spec, action.Type)))
+}
+
 func (d *Reconciler) executeActionCheckProgress(ctx context.Context, action Action) (ready bool, abort bool, retErr error) {
 	retErr = panics.RecoverWithSection("ActionProgress", func() (err error) {
 		ready, abort, err = action.CheckProgress(ctx)
@@ -0,0 +1,82 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// isPaused returns true when reconciliation of the deployment is paused by the spec field or annotation
+func (d *Reconciler) isPaused() bool {
+	return api.IsReconciliationPaused(d.context.GetAPIObject(), d.context.GetSpec())
+}
+
+// CancelPlan removes actions which are not yet started from the high priority and normal plans.
+// Started action is kept in the plan and finished first. Cancelled actions are kept in the plan history and reported as events.
+func (d *Reconciler) CancelPlan(ctx context.Context) error {
+	status := d.context.GetStatus()
+
+	var history api.PlanHistory
+
+	for _, pg := range []planner{plannerHigh{}, plannerNormal{}} {
+		plan := pg.Get(&status)
+		if len(plan) == 0 {
+			continue
+		}
+
+		var keep api.Plan
+		if plan[0].IsStarted() {
+			keep, plan = plan[:1], plan[1:]
+		}
+
+		if len(plan) == 0 {
+			continue
+		}
+
+		for _, planAction := range plan {
+			history = append(history, api.NewPlanHistoryEntry(planAction, pg.Type(), api.PlanHistoryResultCancelled, nil))
+		}
+
+		pg.Set(&status, keep)
+	}
+
+	if len(history) == 0 {
+		return nil
+	}
+
+	status.PlanHistory = status.PlanHistory.Append(history...)
+
+	if err := d.context.UpdateStatus(ctx, status); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, h := range history {
+		d.context.CreateEvent(k8sutil.NewPlanCancelledEvent(d.context.GetAPIObject(), h.Type.String(), h.MemberID, h.Group.AsRole()))
+	}
+
+	d.planLogger.Int("actions", len(history)).Info("Plan cancelled")
+
+	return nil
+}
@@ -0,0 +1,71 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+)
+
+func Test_CancelPlan(t *testing.T) {
+	// Arrange
+	started := actions.NewClusterAction(api.ActionTypeSetCurrentImage)
+	started.StartTime = &meta.Time{Time: meta.Now().Time}
+
+	c := &testContext{
+		ArangoDeployment: &api.ArangoDeployment{
+			Status: api.DeploymentStatus{
+				Plan: api.Plan{
+					started,
+					actions.NewClusterAction(api.ActionTypeEnableMaintenance),
+				},
+				HighPriorityPlan: api.Plan{
+					actions.NewClusterAction(api.ActionTypeDisableMaintenance),
+				},
+			},
+		},
+	}
+
+	r := newTestReconciler()
+	r.context = c
+
+	// Act
+	require.NoError(t, r.CancelPlan(context.Background()))
+
+	// Assert
+	status := c.GetStatus()
+	require.Len(t, status.Plan, 1)
+	require.Equal(t, started.ID, status.Plan[0].ID)
+	require.Empty(t, status.HighPriorityPlan)
+
+	require.Len(t, status.PlanHistory, 2)
+	for _, h := range status.PlanHistory {
+		require.Equal(t, api.PlanHistoryResultCancelled, h.Result)
+		require.NotEqual(t, api.ActionTypeSetCurrentImage, h.Type)
+	}
+	require.NotNil(t, c.RecordedEvent)
+}
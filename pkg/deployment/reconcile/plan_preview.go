@@ -0,0 +1,196 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	core "k8s.io/api/core/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/actions"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/resources"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/rotation"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// PlanPreview contains plans which reconciler would create for the proposed spec.
+type PlanPreview struct {
+	High      api.Plan
+	Resources api.Plan
+	Normal    api.Plan
+
+	// Members contains rotation decision for each member
+	Members []PlanPreviewMember
+}
+
+// PlanPreviewMember contains rotation decision for the member.
+type PlanPreviewMember struct {
+	Group  api.ServerGroup
+	ID     string
+	Mode   rotation.Mode
+	Reason string
+	Plan   api.Plan
+}
+
+// PreviewPlan computes plans for the proposed spec without saving them in the status.
+// Current plans are ignored, so the result shows the actions which would be created once the deployment is idle.
+func (r *Reconciler) PreviewPlan(ctx context.Context, spec api.DeploymentSpec) (PlanPreview, error) {
+	return r.detached().previewPlan(ctx, spec)
+}
+
+// detached returns copy of the reconciler which does not share metrics and volume checks with the reconciler,
+// so state changed by the plan builders is dropped.
+func (r *Reconciler) detached() *Reconciler {
+	return &Reconciler{
+		namespace:  r.namespace,
+		name:       r.name,
+		log:        r.log,
+		planLogger: r.planLogger,
+		context:    r.context,
+	}
+}
+
+func (r *Reconciler) previewPlan(ctx context.Context, spec api.DeploymentSpec) (PlanPreview, error) {
+	apiObject := r.context.GetAPIObject()
+	status := r.context.GetStatus()
+	builderCtx := newPlanPreviewContext(newPlanBuilderContext(r.context), spec)
+
+	var preview PlanPreview
+
+	preview.High, _, _ = r.createHighPlan(ctx, apiObject, nil, spec, status, builderCtx)
+	preview.Resources, _, _ = r.createResourcesPlan(ctx, apiObject, nil, spec, status, builderCtx)
+	preview.Normal, _, _ = r.createNormalPlan(ctx, apiObject, nil, spec, status, builderCtx)
+
+	for _, e := range status.Members.AsList() {
+		if e.Member.Phase == api.MemberPhaseNone {
+			continue
+		}
+
+		m, err := r.previewMemberRotation(ctx, apiObject, spec, status, e.Group, e.Member, builderCtx)
+		if err != nil {
+			return PlanPreview{}, err
+		}
+
+		preview.Members = append(preview.Members, m)
+	}
+
+	return preview, nil
+}
+
+// previewMemberRotation renders pod template of the member for the proposed spec and checks which rotation it requires.
+func (r *Reconciler) previewMemberRotation(ctx context.Context, apiObject k8sutil.APIObject, spec api.DeploymentSpec,
+	status api.DeploymentStatus, group api.ServerGroup, member api.MemberStatus,
+	planCtx PlanBuilderContext) (PlanPreviewMember, error) {
+	result := PlanPreviewMember{
+		Group: group,
+		ID:    member.ID,
+		Mode:  rotation.SkippedRotation,
+	}
+
+	imageInfo, imageFound := planCtx.SelectImageForMember(spec, status, member)
+	if !imageFound {
+		result.Reason = "Image is not yet discovered"
+		return result, nil
+	}
+
+	arangoMember, ok := planCtx.ACS().CurrentClusterCache().ArangoMember().V1().GetSimple(member.ArangoMemberName(apiObject.GetName(), group))
+	if !ok {
+		result.Reason = "ArangoMember not found"
+		return result, nil
+	}
+
+	renderedPod, err := planCtx.RenderPodTemplateForMember(ctx, planCtx.ACS(), spec, status, member.ID, imageInfo)
+	if err != nil {
+		return PlanPreviewMember{}, err
+	}
+
+	checksum, err := resources.ChecksumArangoPod(spec.GetServerGroupSpec(group), resources.CreatePodFromTemplate(renderedPod))
+	if err != nil {
+		return PlanPreviewMember{}, err
+	}
+
+	template, err := api.GetArangoMemberPodTemplate(renderedPod, checksum)
+	if err != nil {
+		return PlanPreviewMember{}, err
+	}
+
+	if z := member.Endpoint; z != nil {
+		q := *z
+		template.Endpoint = &q
+	}
+
+	var pod *core.Pod
+	if cache, ok := planCtx.ACS().ClusterCache(member.ClusterID); ok {
+		if p, ok := cache.Pod().V1().GetSimple(member.Pod.GetName()); ok {
+			pod = p
+		}
+	}
+
+	mode, plan, reason, err := rotation.IsRotationRequired(planCtx.ACS(), spec, member, group, pod, template, arangoMember.Status.Template)
+	if err != nil {
+		return PlanPreviewMember{}, err
+	}
+
+	result.Mode = mode
+	result.Reason = reason
+
+	switch mode {
+	case rotation.EnforcedRotation, rotation.GracefulRotation:
+		result.Plan = r.createRotateMemberPlan(member, group, spec, reason)
+	case rotation.InPlaceRotation:
+		result.Plan = plan
+	case rotation.SilentRotation:
+		result.Plan = api.Plan{actions.NewAction(api.ActionTypeArangoMemberUpdatePodStatus, group, member, "Propagating status of pod").AddParam(ActionTypeArangoMemberUpdatePodStatusChecksum, template.GetChecksum())}
+	}
+
+	return result, nil
+}
+
+func newPlanPreviewContext(ctx PlanBuilderContext, spec api.DeploymentSpec) PlanBuilderContext {
+	return planPreviewContext{
+		PlanBuilderContext: ctx,
+		spec:               spec,
+	}
+}
+
+// planPreviewContext returns proposed spec and drops all side effects of plan builders.
+type planPreviewContext struct {
+	PlanBuilderContext
+
+	spec api.DeploymentSpec
+}
+
+func (p planPreviewContext) GetSpec() api.DeploymentSpec {
+	return p.spec
+}
+
+func (p planPreviewContext) SetAgencyMaintenanceMode(_ context.Context, _ bool) error {
+	return nil
+}
+
+func (p planPreviewContext) CreateEvent(_ *k8sutil.Event) {
+}
+
+func (p planPreviewContext) UpdateRestoreStatus(_ context.Context, _ *backupApi.ArangoRestore) error {
+	return nil
+}
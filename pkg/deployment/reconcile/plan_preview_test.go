@@ -0,0 +1,111 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/member"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/rotation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/kclient"
+	"github.com/arangodb/kube-arangodb/pkg/util/tests"
+)
+
+func Test_PreviewPlan(t *testing.T) {
+	// Arrange
+	depl := &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "test_depl",
+			Namespace: tests.FakeNamespace,
+		},
+		Spec: api.DeploymentSpec{
+			Mode: api.NewMode(api.DeploymentModeCluster),
+			TLS: api.TLSSpec{
+				CASecretName: util.NewString(api.CASecretNameDisabled),
+			},
+		},
+	}
+	addAgentsToStatus(t, &depl.Status, 3)
+	for _, id := range []string{"1", "2", "3"} {
+		require.NoError(t, depl.Status.Members.Add(api.MemberStatus{
+			ID:  id,
+			Pod: &api.MemberPodStatus{Name: "dbserver" + id},
+		}, api.ServerGroupDBServers))
+		require.NoError(t, depl.Status.Members.Add(api.MemberStatus{
+			ID:  id,
+			Pod: &api.MemberPodStatus{Name: "coordinator" + id},
+		}, api.ServerGroupCoordinators))
+	}
+	depl.Spec.SetDefaults("previewPlanTest")
+	depl.Status.Members.DBServers[0].Phase = api.MemberPhaseCreated
+
+	c := &testContext{
+		ArangoDeployment: depl,
+		Inspector:        tests.NewInspector(t, kclient.FakeDataInput{Namespace: tests.FakeNamespace}.Client()),
+		state: &FakeStateInspector{
+			state: member.State{
+				NotReachableErr: errors.New("Client Not Found"),
+			},
+		},
+	}
+
+	r := newTestReconciler()
+	r.context = c
+	r.metrics.GetShards().SetZoneViolations(5)
+
+	spec := depl.Spec.DeepCopy()
+	spec.DBServers.Count = util.NewInt(2)
+
+	// Act
+	preview, err := r.PreviewPlan(context.Background(), *spec)
+
+	// Assert
+	require.NoError(t, err)
+
+	require.NotEmpty(t, preview.Normal)
+	require.Equal(t, api.ActionTypeCleanOutMember, preview.Normal[0].Type)
+	require.Equal(t, api.ServerGroupDBServers, preview.Normal[0].Group)
+
+	require.Len(t, preview.Members, 4)
+	for _, m := range preview.Members {
+		if m.Group == api.ServerGroupDBServers {
+			require.Equal(t, "1", m.ID)
+			require.Equal(t, rotation.SkippedRotation, m.Mode)
+		}
+	}
+
+	require.Empty(t, c.GetStatus().Plan)
+	require.Nil(t, c.RecordedEvent)
+	require.Equal(t, 3, depl.Spec.DBServers.GetCount())
+
+	// State of the reconciler is not changed
+	require.True(t, r.metrics.Shards.zonesKnown)
+	require.Equal(t, 5, r.metrics.Shards.zoneViolations)
+	require.False(t, r.metrics.Rebalancer.enabled)
+	require.Nil(t, r.volumeAutoGrowChecks.checks)
+}
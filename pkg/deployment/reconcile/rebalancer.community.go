@@ -0,0 +1,433 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//go:build !enterprise
+// +build !enterprise
+
+package reconcile
+
+import (
+	"sort"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+)
+
+const (
+	// rebalancerDefaultParallelMoves define how many shards are moved at once by default
+	rebalancerDefaultParallelMoves = 10
+	// rebalancerJobDiscoveryTimeout define how long move job is expected to be missing in the agency cache
+	rebalancerJobDiscoveryTimeout = 5 * time.Minute
+)
+
+// rebalancerMove define single move of the shard replica
+type rebalancerMove struct {
+	Database       string
+	Collection     string
+	CollectionName string
+	Shard          string
+
+	From, To agency.Server
+
+	// Leader is set when leadership is moved to the server which already holds the follower replica
+	Leader bool
+}
+
+// rebalancerShard keeps the shard which can be moved by the rebalancer
+type rebalancerShard struct {
+	Database       string
+	Collection     string
+	CollectionName string
+	Shard          string
+	Servers        agency.Servers
+	InSync         agency.Servers
+
+	// Weight define how many replicas are moved together with the shard (distributeShardsLike followers included)
+	Weight int
+}
+
+// rebalancerServers returns DBServers which can hold shards moved by the rebalancer
+func rebalancerServers(status api.DeploymentStatus, state agency.State) agency.Servers {
+	var servers agency.Servers
+
+	for _, m := range status.Members.DBServers {
+		if m.Phase != api.MemberPhaseCreated || !m.Conditions.IsTrue(api.ConditionTypeReady) {
+			continue
+		}
+
+		if m.Conditions.IsTrue(api.ConditionTypeMarkedToRemove) || m.Conditions.IsTrue(api.ConditionTypeCleanedOut) {
+			continue
+		}
+
+		if state.Target.CleanedServers.Contains(agency.Server(m.ID)) {
+			continue
+		}
+
+		servers = append(servers, agency.Server(m.ID))
+	}
+
+	return servers
+}
+
+// rebalancerShards returns shards which can be moved, in stable order
+func rebalancerShards(state agency.State) []rebalancerShard {
+	var shards []rebalancerShard
+
+	for db, collections := range state.Plan.Collections {
+		followers := map[string]int{}
+		for _, col := range collections {
+			if d := col.DistributeShardsLike; d != nil {
+				followers[*d]++
+			}
+		}
+
+		for colID, col := range collections {
+			if col.DistributeShardsLike != nil {
+				// Shards of the collection follow the prototype
+				continue
+			}
+
+			for shard, servers := range col.Shards {
+				shards = append(shards, rebalancerShard{
+					Database:       db,
+					Collection:     colID,
+					CollectionName: col.GetName(colID),
+					Shard:          shard,
+					Servers:        servers,
+					InSync:         state.Current.Collections[db][colID][shard].Servers,
+					Weight:         1 + followers[colID],
+				})
+			}
+		}
+	}
+
+	sort.Slice(shards, func(i, j int) bool {
+		if shards[i].Database != shards[j].Database {
+			return shards[i].Database < shards[j].Database
+		}
+		if shards[i].Collection != shards[j].Collection {
+			return shards[i].Collection < shards[j].Collection
+		}
+		return shards[i].Shard < shards[j].Shard
+	})
+
+	return shards
+}
+
+// isInSync returns true when all replicas of the shard are in sync and placed on the rebalanced servers
+func (r rebalancerShard) isInSync(servers agency.Servers) bool {
+	if len(r.Servers) == 0 {
+		return false
+	}
+
+	for _, s := range r.Servers {
+		if !servers.Contains(s) || !r.InSync.Contains(s) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// zonesAfterMove returns number of zones used by the shard replicas after move of the replica
+func (r rebalancerShard) zonesAfterMove(zones agency.ServerZones, from, to agency.Server) int {
+	used := map[string]bool{}
+
+	for _, s := range r.Servers {
+		if s == from {
+			s = to
+		}
+
+		used[zones[s]] = true
+	}
+
+	return len(used)
+}
+
+// rebalancerGenerateMoves generates up to the limit moves which balance the number of the shard replicas
+// and, if leader optimizer is enabled, number of the shard leaders across servers.
+// If zones are provided, replicas are spread across zones first and other moves keep the zone diversity.
+func rebalancerGenerateMoves(state agency.State, servers agency.Servers, limit int, leaders bool, zones agency.ServerZones) []rebalancerMove {
+	if len(servers) < 2 || limit <= 0 {
+		return nil
+	}
+
+	shards := rebalancerShards(state)
+
+	replicaCount := map[agency.Server]int{}
+	leaderCount := map[agency.Server]int{}
+
+	for _, s := range servers {
+		replicaCount[s] = 0
+		leaderCount[s] = 0
+	}
+
+	var candidates []int
+
+	for id, shard := range shards {
+		for i, s := range shard.Servers {
+			if _, ok := replicaCount[s]; !ok {
+				continue
+			}
+
+			replicaCount[s] += shard.Weight
+			if i == 0 {
+				leaderCount[s] += shard.Weight
+			}
+		}
+
+		if shard.isInSync(servers) {
+			candidates = append(candidates, id)
+		}
+	}
+
+	moved := map[int]bool{}
+
+	var moves []rebalancerMove
+
+	if zones != nil {
+		moves = rebalancerGenerateZoneMoves(shards, candidates, servers, limit, zones, moved, replicaCount, leaderCount)
+	}
+
+	// Balance number of replicas
+	for len(moves) < limit {
+		from, to := rebalancerMinMax(servers, replicaCount)
+		diff := replicaCount[from] - replicaCount[to]
+
+		found := false
+
+		// Followers are moved first, leader moves change leadership too
+		for _, leader := range []bool{false, true} {
+			for _, id := range candidates {
+				shard := shards[id]
+				if moved[id] || shard.Weight >= diff || shard.Servers.Contains(to) {
+					continue
+				}
+
+				if (shard.Servers[0] == from) != leader || !shard.Servers.Contains(from) {
+					continue
+				}
+
+				if zones != nil && shard.zonesAfterMove(zones, from, to) < shard.zonesAfterMove(zones, "", "") {
+					// Keep replicas spread across zones
+					continue
+				}
+
+				moves = append(moves, rebalancerMove{
+					Database:       shard.Database,
+					Collection:     shard.Collection,
+					CollectionName: shard.CollectionName,
+					Shard:          shard.Shard,
+					From:           from,
+					To:             to,
+				})
+				moved[id] = true
+
+				replicaCount[from] -= shard.Weight
+				replicaCount[to] += shard.Weight
+				if leader {
+					leaderCount[from] -= shard.Weight
+					leaderCount[to] += shard.Weight
+				}
+
+				found = true
+				break
+			}
+
+			if found {
+				break
+			}
+		}
+
+		if !found {
+			break
+		}
+	}
+
+	if !leaders {
+		return moves
+	}
+
+	// Balance number of leaders
+	for len(moves) < limit {
+		from, to := rebalancerMinMax(servers, leaderCount)
+		diff := leaderCount[from] - leaderCount[to]
+
+		found := false
+
+		for _, id := range candidates {
+			shard := shards[id]
+			if moved[id] || shard.Weight >= diff || shard.Servers[0] != from || !shard.Servers.Contains(to) {
+				continue
+			}
+
+			moves = append(moves, rebalancerMove{
+				Database:       shard.Database,
+				Collection:     shard.Collection,
+				CollectionName: shard.CollectionName,
+				Shard:          shard.Shard,
+				From:           from,
+				To:             to,
+				Leader:         true,
+			})
+			moved[id] = true
+
+			leaderCount[from] -= shard.Weight
+			leaderCount[to] += shard.Weight
+
+			found = true
+			break
+		}
+
+		if !found {
+			break
+		}
+	}
+
+	return moves
+}
+
+// rebalancerGenerateZoneMoves generates moves of the replicas which share the zone with other replicas of the shard
+// to the least loaded server in the zone which is not used by the shard
+func rebalancerGenerateZoneMoves(shards []rebalancerShard, candidates []int, servers agency.Servers, limit int, zones agency.ServerZones,
+	moved map[int]bool, replicaCount, leaderCount map[agency.Server]int) []rebalancerMove {
+	available := len(zones.Zones())
+	if available < 2 {
+		return nil
+	}
+
+	var moves []rebalancerMove
+
+	for _, id := range candidates {
+		if len(moves) >= limit {
+			break
+		}
+
+		shard := shards[id]
+
+		current, ok := zones.Get(shard.Servers)
+		if !ok {
+			continue
+		}
+
+		expected := len(shard.Servers)
+		if expected > available {
+			expected = available
+		}
+
+		if shard.zonesAfterMove(zones, "", "") >= expected {
+			continue
+		}
+
+		// Followers are moved first
+		for i := len(shard.Servers) - 1; i >= 0; i-- {
+			from := shard.Servers[i]
+
+			shared := 0
+			for _, z := range current {
+				if z == current[i] {
+					shared++
+				}
+			}
+
+			if shared < 2 {
+				continue
+			}
+
+			var to agency.Server
+			for _, s := range servers {
+				if shard.Servers.Contains(s) || zones[s] == "" || shard.zonesAfterMove(zones, from, s) <= shard.zonesAfterMove(zones, "", "") {
+					continue
+				}
+
+				if to == "" || replicaCount[s] < replicaCount[to] {
+					to = s
+				}
+			}
+
+			if to == "" {
+				continue
+			}
+
+			moves = append(moves, rebalancerMove{
+				Database:       shard.Database,
+				Collection:     shard.Collection,
+				CollectionName: shard.CollectionName,
+				Shard:          shard.Shard,
+				From:           from,
+				To:             to,
+			})
+			moved[id] = true
+
+			replicaCount[from] -= shard.Weight
+			replicaCount[to] += shard.Weight
+			if i == 0 {
+				leaderCount[from] -= shard.Weight
+				leaderCount[to] += shard.Weight
+			}
+
+			break
+		}
+	}
+
+	return moves
+}
+
+// rebalancerMinMax returns the most and the least loaded servers
+func rebalancerMinMax(servers agency.Servers, count map[agency.Server]int) (max, min agency.Server) {
+	max, min = servers[0], servers[0]
+
+	for _, s := range servers[1:] {
+		if count[s] > count[max] {
+			max = s
+		}
+		if count[s] < count[min] {
+			min = s
+		}
+	}
+
+	return
+}
+
+// rebalancerCheckJobs returns move jobs which are still in progress together with the number of finished and failed jobs
+func rebalancerCheckJobs(status *api.ArangoDeploymentRebalancerStatus, state agency.State, now time.Time) (remaining []string, succeeded, failed int) {
+	if !status.IsMoveInProgress() {
+		return nil, 0, 0
+	}
+
+	for _, id := range status.MoveJobs {
+		_, phase := state.Target.GetJob(agency.JobID(id))
+		switch phase {
+		case agency.JobPhaseFinished:
+			succeeded++
+		case agency.JobPhaseFailed:
+			failed++
+		case agency.JobPhaseUnknown:
+			if t := status.LastCheckTime; t == nil || now.Sub(t.Time) > rebalancerJobDiscoveryTimeout {
+				failed++
+			} else {
+				remaining = append(remaining, id)
+			}
+		default:
+			remaining = append(remaining, id)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,184 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//go:build !enterprise
+// +build !enterprise
+
+package reconcile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+)
+
+func newRebalancerTestState(shards map[string]agency.Servers) agency.State {
+	var s agency.State
+
+	plan := agency.Shards{}
+	current := agency.StateCurrentDBCollection{}
+
+	for k, v := range shards {
+		plan[k] = v
+		current[k] = agency.StateCurrentDBShard{Servers: v}
+	}
+
+	s.Plan.Collections = agency.StatePlanCollections{
+		"_system": agency.StatePlanDBCollections{
+			"1": agency.StatePlanCollection{Shards: plan},
+		},
+	}
+	s.Current.Collections = agency.StateCurrentCollections{
+		"_system": agency.StateCurrentDBCollections{
+			"1": current,
+		},
+	}
+
+	return s
+}
+
+func Test_RebalancerGenerateMoves(t *testing.T) {
+	servers := agency.Servers{"A", "B", "C"}
+
+	t.Run("Balanced", func(t *testing.T) {
+		s := newRebalancerTestState(map[string]agency.Servers{
+			"s1": {"A", "B"},
+			"s2": {"B", "C"},
+			"s3": {"C", "A"},
+		})
+
+		require.Len(t, rebalancerGenerateMoves(s, servers, 10, true, nil), 0)
+	})
+
+	t.Run("New server", func(t *testing.T) {
+		s := newRebalancerTestState(map[string]agency.Servers{
+			"s1": {"A", "B"},
+			"s2": {"B", "A"},
+			"s3": {"A", "B"},
+			"s4": {"B", "A"},
+		})
+
+		moves := rebalancerGenerateMoves(s, servers, 10, true, nil)
+		require.Len(t, moves, 2)
+		for _, m := range moves {
+			require.Equal(t, agency.Server("C"), m.To)
+			require.False(t, m.Leader)
+		}
+	})
+
+	t.Run("Parallel moves limit", func(t *testing.T) {
+		s := newRebalancerTestState(map[string]agency.Servers{
+			"s1": {"A", "B"},
+			"s2": {"B", "A"},
+			"s3": {"A", "B"},
+			"s4": {"B", "A"},
+		})
+
+		require.Len(t, rebalancerGenerateMoves(s, servers, 1, true, nil), 1)
+	})
+
+	t.Run("Leaders", func(t *testing.T) {
+		s := newRebalancerTestState(map[string]agency.Servers{
+			"s1": {"A", "B"},
+			"s2": {"A", "B"},
+		})
+
+		moves := rebalancerGenerateMoves(s, agency.Servers{"A", "B"}, 10, true, nil)
+		require.Len(t, moves, 1)
+		require.True(t, moves[0].Leader)
+		require.Equal(t, agency.Server("A"), moves[0].From)
+		require.Equal(t, agency.Server("B"), moves[0].To)
+
+		require.Len(t, rebalancerGenerateMoves(s, agency.Servers{"A", "B"}, 10, false, nil), 0)
+	})
+
+	t.Run("Not in sync", func(t *testing.T) {
+		s := newRebalancerTestState(map[string]agency.Servers{
+			"s1": {"A", "B"},
+			"s2": {"B", "A"},
+			"s3": {"A", "B"},
+			"s4": {"B", "A"},
+		})
+		for k := range s.Current.Collections["_system"]["1"] {
+			s.Current.Collections["_system"]["1"][k] = agency.StateCurrentDBShard{Servers: agency.Servers{"A"}}
+		}
+
+		require.Len(t, rebalancerGenerateMoves(s, servers, 10, true, nil), 0)
+	})
+}
+
+func Test_RebalancerGenerateZoneMoves(t *testing.T) {
+	servers := agency.Servers{"A", "B", "C", "D"}
+	zones := agency.ServerZones{"A": "zone-a", "B": "zone-a", "C": "zone-b", "D": "zone-b"}
+
+	s := newRebalancerTestState(map[string]agency.Servers{
+		"s1": {"A", "B"},
+		"s2": {"C", "A"},
+		"s3": {"D", "B"},
+		"s4": {"C", "D"},
+	})
+
+	t.Run("Without zones", func(t *testing.T) {
+		require.Len(t, rebalancerGenerateMoves(s, servers, 10, false, nil), 0)
+	})
+
+	t.Run("With zones", func(t *testing.T) {
+		moves := rebalancerGenerateMoves(s, servers, 10, false, zones)
+		require.Len(t, moves, 3)
+
+		require.Equal(t, "s1", moves[0].Shard)
+		require.Equal(t, agency.Server("B"), moves[0].From)
+		require.Equal(t, "zone-b", zones[moves[0].To])
+
+		require.Equal(t, "s4", moves[1].Shard)
+		require.Equal(t, agency.Server("D"), moves[1].From)
+		require.Equal(t, "zone-a", zones[moves[1].To])
+
+		// Replica count is balanced without breaking the zone diversity
+		require.Equal(t, "s2", moves[2].Shard)
+		require.Equal(t, agency.Server("C"), moves[2].From)
+		require.Equal(t, agency.Server("D"), moves[2].To)
+	})
+}
+
+func Test_RebalancerCheckJobs(t *testing.T) {
+	var s agency.State
+	s.Target.JobFinished = agency.Jobs{"1": {}}
+	s.Target.JobFailed = agency.Jobs{"2": {}}
+	s.Target.JobPending = agency.Jobs{"3": {}}
+
+	now := time.Now()
+	status := &api.ArangoDeploymentRebalancerStatus{
+		LastCheckTime: &meta.Time{Time: now},
+		MoveJobs:      []string{"1", "2", "3", "4"},
+	}
+
+	remaining, succeeded, failed := rebalancerCheckJobs(status, s, now)
+	require.Equal(t, []string{"3", "4"}, remaining)
+	require.Equal(t, 1, succeeded)
+	require.Equal(t, 1, failed)
+
+	remaining, _, failed = rebalancerCheckJobs(status, s, now.Add(2*rebalancerJobDiscoveryTimeout))
+	require.Equal(t, []string{"3"}, remaining)
+	require.Equal(t, 2, failed)
+}
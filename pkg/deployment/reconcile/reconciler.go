@@ -41,6 +41,8 @@ type Reconciler struct {
 	context         Context
 
 	metrics Metrics
+
+	volumeAutoGrowChecks volumeAutoGrowChecks
 }
 
 // NewReconciler creates a new reconciler with given context.
@@ -28,6 +28,7 @@ import (
 	"github.com/arangodb/arangosync-client/client"
 	"github.com/arangodb/go-driver"
 
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/acs/sutil"
 	agencyCache "github.com/arangodb/kube-arangodb/pkg/deployment/agency"
@@ -134,6 +135,16 @@ type DeploymentSyncClient interface {
 	GetSyncServerClient(ctx context.Context, group api.ServerGroup, id string) (client.API, error)
 }
 
+// ArangoRestoreContext provides access to the ArangoRestore resources of the deployment.
+type ArangoRestoreContext interface {
+	// GetRestore receives information about a restore resource
+	GetRestore(ctx context.Context, restore string) (*backupApi.ArangoRestore, error)
+	// ListRestores returns restore resources which target the deployment
+	ListRestores(ctx context.Context) ([]backupApi.ArangoRestore, error)
+	// UpdateRestoreStatus updates the status of the restore resource
+	UpdateRestoreStatus(ctx context.Context, restore *backupApi.ArangoRestore) error
+}
+
 type KubernetesEventGenerator interface {
 	// CreateEvent creates a given event.
 	// On error, the error is logged.
@@ -0,0 +1,186 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"context"
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/throttle"
+)
+
+func init() {
+	requireRegisterInspectorLoader(arangoMemberGroupsInspectorLoaderObj)
+}
+
+var arangoMemberGroupsInspectorLoaderObj = arangoMemberGroupsInspectorLoader{}
+
+type arangoMemberGroupsInspectorLoader struct {
+}
+
+func (p arangoMemberGroupsInspectorLoader) Component() throttle.Component {
+	return throttle.ArangoMemberGroup
+}
+
+func (p arangoMemberGroupsInspectorLoader) Load(ctx context.Context, i *inspectorState) {
+	var q arangoMemberGroupsInspector
+	p.loadV1(ctx, i, &q)
+	i.arangoMemberGroups = &q
+	q.state = i
+	q.last = time.Now()
+}
+
+func (p arangoMemberGroupsInspectorLoader) loadV1(ctx context.Context, i *inspectorState, q *arangoMemberGroupsInspector) {
+	var z arangoMemberGroupsInspectorV1
+
+	z.arangoMemberGroupInspector = q
+
+	z.arangoMemberGroups, z.err = p.getV1ArangoMemberGroups(ctx, i)
+
+	q.v1 = &z
+}
+
+func (p arangoMemberGroupsInspectorLoader) getV1ArangoMemberGroups(ctx context.Context, i *inspectorState) (map[string]*api.ArangoMemberGroup, error) {
+	objs, err := p.getV1ArangoMemberGroupsList(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make(map[string]*api.ArangoMemberGroup, len(objs))
+
+	for id := range objs {
+		r[objs[id].GetName()] = objs[id]
+	}
+
+	return r, nil
+}
+
+func (p arangoMemberGroupsInspectorLoader) getV1ArangoMemberGroupsList(ctx context.Context, i *inspectorState) ([]*api.ArangoMemberGroup, error) {
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+	obj, err := i.client.Arango().DatabaseV1().ArangoMemberGroups(i.namespace).List(ctxChild, meta.ListOptions{
+		Limit: globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	items := obj.Items
+	cont := obj.Continue
+	var s = int64(len(items))
+
+	if z := obj.RemainingItemCount; z != nil {
+		s += *z
+	}
+
+	ptrs := make([]*api.ArangoMemberGroup, 0, s)
+
+	for {
+		for id := range items {
+			ptrs = append(ptrs, &items[id])
+		}
+
+		if cont == "" {
+			break
+		}
+
+		items, cont, err = p.getV1ArangoMemberGroupsListRequest(ctx, i, cont)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ptrs, nil
+}
+
+func (p arangoMemberGroupsInspectorLoader) getV1ArangoMemberGroupsListRequest(ctx context.Context, i *inspectorState, cont string) ([]api.ArangoMemberGroup, string, error) {
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+	obj, err := i.client.Arango().DatabaseV1().ArangoMemberGroups(i.namespace).List(ctxChild, meta.ListOptions{
+		Limit:    globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+		Continue: cont,
+	})
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	return obj.Items, obj.Continue, err
+}
+
+func (p arangoMemberGroupsInspectorLoader) Verify(i *inspectorState) error {
+	return nil
+}
+
+func (p arangoMemberGroupsInspectorLoader) Copy(from, to *inspectorState, override bool) {
+	if to.arangoMemberGroups != nil {
+		if !override {
+			return
+		}
+	}
+
+	to.arangoMemberGroups = from.arangoMemberGroups
+	to.arangoMemberGroups.state = to
+}
+
+func (p arangoMemberGroupsInspectorLoader) Name() string {
+	return "arangoMemberGroups"
+}
+
+type arangoMemberGroupsInspector struct {
+	state *inspectorState
+
+	last time.Time
+
+	v1 *arangoMemberGroupsInspectorV1
+}
+
+func (p *arangoMemberGroupsInspector) LastRefresh() time.Time {
+	return p.last
+}
+
+func (p *arangoMemberGroupsInspector) Refresh(ctx context.Context) error {
+	p.Throttle(p.state.throttles).Invalidate()
+	return p.state.refresh(ctx, arangoMemberGroupsInspectorLoaderObj)
+}
+
+func (p arangoMemberGroupsInspector) Throttle(c throttle.Components) throttle.Throttle {
+	return c.ArangoMemberGroup()
+}
+
+func (p *arangoMemberGroupsInspector) validate() error {
+	if p == nil {
+		return errors.Newf("ArangoMemberGroupInspector is nil")
+	}
+
+	if p.state == nil {
+		return errors.Newf("Parent is nil")
+	}
+
+	return p.v1.validate()
+}
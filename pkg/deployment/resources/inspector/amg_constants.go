@@ -0,0 +1,82 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	deploymentv1 "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+)
+
+// ArangoMemberGroup
+const (
+	ArangoMemberGroupGroup     = deployment.ArangoDeploymentGroupName
+	ArangoMemberGroupResource  = deployment.ArangoMemberGroupResourcePlural
+	ArangoMemberGroupKind      = deployment.ArangoMemberGroupResourceKind
+	ArangoMemberGroupVersionV1 = deploymentv1.ArangoDeploymentVersion
+)
+
+func ArangoMemberGroupGK() schema.GroupKind {
+	return schema.GroupKind{
+		Group: ArangoMemberGroupGroup,
+		Kind:  ArangoMemberGroupKind,
+	}
+}
+
+func ArangoMemberGroupGKv1() schema.GroupVersionKind {
+	return schema.GroupVersionKind{
+		Group:   ArangoMemberGroupGroup,
+		Kind:    ArangoMemberGroupKind,
+		Version: ArangoMemberGroupVersionV1,
+	}
+}
+
+func ArangoMemberGroupGR() schema.GroupResource {
+	return schema.GroupResource{
+		Group:    ArangoMemberGroupGroup,
+		Resource: ArangoMemberGroupResource,
+	}
+}
+
+func ArangoMemberGroupGRv1() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    ArangoMemberGroupGroup,
+		Resource: ArangoMemberGroupResource,
+		Version:  ArangoMemberGroupVersionV1,
+	}
+}
+
+func (p *arangoMemberGroupsInspectorV1) GroupVersionKind() schema.GroupVersionKind {
+	return ArangoMemberGroupGKv1()
+}
+
+func (p *arangoMemberGroupsInspectorV1) GroupVersionResource() schema.GroupVersionResource {
+	return ArangoMemberGroupGRv1()
+}
+
+func (p *arangoMemberGroupsInspector) GroupKind() schema.GroupKind {
+	return ArangoMemberGroupGK()
+}
+
+func (p *arangoMemberGroupsInspector) GroupResource() schema.GroupResource {
+	return ArangoMemberGroupGR()
+}
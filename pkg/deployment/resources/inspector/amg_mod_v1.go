@@ -0,0 +1,89 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"context"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	arangoClient "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/typed/deployment/v1"
+	arangomembergroupv1 "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangomembergroup/v1"
+)
+
+func (p arangoMemberGroupsMod) V1() arangomembergroupv1.ModInterface {
+	return arangoMemberGroupsModV1(p)
+}
+
+type arangoMemberGroupsModV1 struct {
+	i *inspectorState
+}
+
+func (p arangoMemberGroupsModV1) client() arangoClient.ArangoMemberGroupInterface {
+	return p.i.Client().Arango().DatabaseV1().ArangoMemberGroups(p.i.Namespace())
+}
+
+func (p arangoMemberGroupsModV1) Create(ctx context.Context, arangoMemberGroup *api.ArangoMemberGroup, opts meta.CreateOptions) (*api.ArangoMemberGroup, error) {
+	if arangoMemberGroup, err := p.client().Create(ctx, arangoMemberGroup, opts); err != nil {
+		return arangoMemberGroup, err
+	} else {
+		p.i.GetThrottles().ArangoMemberGroup().Invalidate()
+		return arangoMemberGroup, err
+	}
+}
+
+func (p arangoMemberGroupsModV1) Update(ctx context.Context, arangoMemberGroup *api.ArangoMemberGroup, opts meta.UpdateOptions) (*api.ArangoMemberGroup, error) {
+	if arangoMemberGroup, err := p.client().Update(ctx, arangoMemberGroup, opts); err != nil {
+		return arangoMemberGroup, err
+	} else {
+		p.i.GetThrottles().ArangoMemberGroup().Invalidate()
+		return arangoMemberGroup, err
+	}
+}
+
+func (p arangoMemberGroupsModV1) UpdateStatus(ctx context.Context, arangoMemberGroup *api.ArangoMemberGroup, opts meta.UpdateOptions) (*api.ArangoMemberGroup, error) {
+	if arangoMemberGroup, err := p.client().UpdateStatus(ctx, arangoMemberGroup, opts); err != nil {
+		return arangoMemberGroup, err
+	} else {
+		p.i.GetThrottles().ArangoMemberGroup().Invalidate()
+		return arangoMemberGroup, err
+	}
+}
+
+func (p arangoMemberGroupsModV1) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts meta.PatchOptions, subresources ...string) (result *api.ArangoMemberGroup, err error) {
+	if arangoMemberGroup, err := p.client().Patch(ctx, name, pt, data, opts, subresources...); err != nil {
+		return arangoMemberGroup, err
+	} else {
+		p.i.GetThrottles().ArangoMemberGroup().Invalidate()
+		return arangoMemberGroup, err
+	}
+}
+
+func (p arangoMemberGroupsModV1) Delete(ctx context.Context, name string, opts meta.DeleteOptions) error {
+	if err := p.client().Delete(ctx, name, opts); err != nil {
+		return err
+	} else {
+		p.i.GetThrottles().ArangoMemberGroup().Invalidate()
+		return err
+	}
+}
@@ -0,0 +1,137 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"context"
+
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	ins "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangomembergroup/v1"
+)
+
+func (p *arangoMemberGroupsInspector) V1() (ins.Inspector, error) {
+	if p.v1.err != nil {
+		return nil, p.v1.err
+	}
+
+	return p.v1, nil
+}
+
+type arangoMemberGroupsInspectorV1 struct {
+	arangoMemberGroupInspector *arangoMemberGroupsInspector
+
+	arangoMemberGroups map[string]*api.ArangoMemberGroup
+	err                error
+}
+
+func (p *arangoMemberGroupsInspectorV1) Filter(filters ...ins.Filter) []*api.ArangoMemberGroup {
+	z := p.ListSimple()
+
+	r := make([]*api.ArangoMemberGroup, 0, len(z))
+
+	for _, o := range z {
+		if !ins.FilterObject(o, filters...) {
+			continue
+		}
+
+		r = append(r, o)
+	}
+
+	return r
+}
+
+func (p *arangoMemberGroupsInspectorV1) validate() error {
+	if p == nil {
+		return errors.Newf("ArangoMemberGroupsV1Inspector is nil")
+	}
+
+	if p.arangoMemberGroupInspector == nil {
+		return errors.Newf("Parent is nil")
+	}
+
+	if p.arangoMemberGroups == nil && p.err == nil {
+		return errors.Newf("ArangoMemberGroups or err should be not nil")
+	}
+
+	if p.arangoMemberGroups != nil && p.err != nil {
+		return errors.Newf("ArangoMemberGroups or err cannot be not nil together")
+	}
+
+	return nil
+}
+
+func (p *arangoMemberGroupsInspectorV1) ListSimple() []*api.ArangoMemberGroup {
+	var r []*api.ArangoMemberGroup
+	for _, arangoMemberGroup := range p.arangoMemberGroups {
+		r = append(r, arangoMemberGroup)
+	}
+
+	return r
+}
+
+func (p *arangoMemberGroupsInspectorV1) GetSimple(name string) (*api.ArangoMemberGroup, bool) {
+	arangoMemberGroup, ok := p.arangoMemberGroups[name]
+	if !ok {
+		return nil, false
+	}
+
+	return arangoMemberGroup, true
+}
+
+func (p *arangoMemberGroupsInspectorV1) Iterate(action ins.Action, filters ...ins.Filter) error {
+	for _, arangoMemberGroup := range p.arangoMemberGroups {
+		if err := p.iterateArangoMemberGroup(arangoMemberGroup, action, filters...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *arangoMemberGroupsInspectorV1) iterateArangoMemberGroup(arangoMemberGroup *api.ArangoMemberGroup, action ins.Action, filters ...ins.Filter) error {
+	for _, f := range filters {
+		if f == nil {
+			continue
+		}
+
+		if !f(arangoMemberGroup) {
+			return nil
+		}
+	}
+
+	return action(arangoMemberGroup)
+}
+
+func (p *arangoMemberGroupsInspectorV1) Read() ins.ReadInterface {
+	return p
+}
+
+func (p *arangoMemberGroupsInspectorV1) Get(ctx context.Context, name string, opts meta.GetOptions) (*api.ArangoMemberGroup, error) {
+	if s, ok := p.GetSimple(name); !ok {
+		return nil, apiErrors.NewNotFound(ArangoMemberGroupGR(), name)
+	} else {
+		return s, nil
+	}
+}
@@ -0,0 +1,186 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"context"
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/throttle"
+)
+
+func init() {
+	requireRegisterInspectorLoader(arangoRestoresInspectorLoaderObj)
+}
+
+var arangoRestoresInspectorLoaderObj = arangoRestoresInspectorLoader{}
+
+type arangoRestoresInspectorLoader struct {
+}
+
+func (p arangoRestoresInspectorLoader) Component() throttle.Component {
+	return throttle.ArangoRestore
+}
+
+func (p arangoRestoresInspectorLoader) Load(ctx context.Context, i *inspectorState) {
+	var q arangoRestoresInspector
+	p.loadV1(ctx, i, &q)
+	i.arangoRestores = &q
+	q.state = i
+	q.last = time.Now()
+}
+
+func (p arangoRestoresInspectorLoader) loadV1(ctx context.Context, i *inspectorState, q *arangoRestoresInspector) {
+	var z arangoRestoresInspectorV1
+
+	z.arangoRestoreInspector = q
+
+	z.arangoRestores, z.err = p.getV1ArangoRestores(ctx, i)
+
+	q.v1 = &z
+}
+
+func (p arangoRestoresInspectorLoader) getV1ArangoRestores(ctx context.Context, i *inspectorState) (map[string]*api.ArangoRestore, error) {
+	objs, err := p.getV1ArangoRestoresList(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make(map[string]*api.ArangoRestore, len(objs))
+
+	for id := range objs {
+		r[objs[id].GetName()] = objs[id]
+	}
+
+	return r, nil
+}
+
+func (p arangoRestoresInspectorLoader) getV1ArangoRestoresList(ctx context.Context, i *inspectorState) ([]*api.ArangoRestore, error) {
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+	obj, err := i.client.Arango().BackupV1().ArangoRestores(i.namespace).List(ctxChild, meta.ListOptions{
+		Limit: globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	items := obj.Items
+	cont := obj.Continue
+	var s = int64(len(items))
+
+	if z := obj.RemainingItemCount; z != nil {
+		s += *z
+	}
+
+	ptrs := make([]*api.ArangoRestore, 0, s)
+
+	for {
+		for id := range items {
+			ptrs = append(ptrs, &items[id])
+		}
+
+		if cont == "" {
+			break
+		}
+
+		items, cont, err = p.getV1ArangoRestoresListRequest(ctx, i, cont)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ptrs, nil
+}
+
+func (p arangoRestoresInspectorLoader) getV1ArangoRestoresListRequest(ctx context.Context, i *inspectorState, cont string) ([]api.ArangoRestore, string, error) {
+	ctxChild, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(ctx)
+	defer cancel()
+	obj, err := i.client.Arango().BackupV1().ArangoRestores(i.namespace).List(ctxChild, meta.ListOptions{
+		Limit:    globals.GetGlobals().Kubernetes().RequestBatchSize().Get(),
+		Continue: cont,
+	})
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	return obj.Items, obj.Continue, err
+}
+
+func (p arangoRestoresInspectorLoader) Verify(i *inspectorState) error {
+	return nil
+}
+
+func (p arangoRestoresInspectorLoader) Copy(from, to *inspectorState, override bool) {
+	if to.arangoRestores != nil {
+		if !override {
+			return
+		}
+	}
+
+	to.arangoRestores = from.arangoRestores
+	to.arangoRestores.state = to
+}
+
+func (p arangoRestoresInspectorLoader) Name() string {
+	return "arangoRestores"
+}
+
+type arangoRestoresInspector struct {
+	state *inspectorState
+
+	last time.Time
+
+	v1 *arangoRestoresInspectorV1
+}
+
+func (p *arangoRestoresInspector) LastRefresh() time.Time {
+	return p.last
+}
+
+func (p *arangoRestoresInspector) Refresh(ctx context.Context) error {
+	p.Throttle(p.state.throttles).Invalidate()
+	return p.state.refresh(ctx, arangoRestoresInspectorLoaderObj)
+}
+
+func (p arangoRestoresInspector) Throttle(c throttle.Components) throttle.Throttle {
+	return c.ArangoRestore()
+}
+
+func (p *arangoRestoresInspector) validate() error {
+	if p == nil {
+		return errors.Newf("ArangoRestoreInspector is nil")
+	}
+
+	if p.state == nil {
+		return errors.Newf("Parent is nil")
+	}
+
+	return p.v1.validate()
+}
@@ -0,0 +1,82 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/backup"
+	backupv1 "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+)
+
+// ArangoRestore
+const (
+	ArangoRestoreGroup     = backup.ArangoBackupGroupName
+	ArangoRestoreResource  = backup.ArangoRestoreResourcePlural
+	ArangoRestoreKind      = backup.ArangoRestoreResourceKind
+	ArangoRestoreVersionV1 = backupv1.ArangoBackupVersion
+)
+
+func ArangoRestoreGK() schema.GroupKind {
+	return schema.GroupKind{
+		Group: ArangoRestoreGroup,
+		Kind:  ArangoRestoreKind,
+	}
+}
+
+func ArangoRestoreGKv1() schema.GroupVersionKind {
+	return schema.GroupVersionKind{
+		Group:   ArangoRestoreGroup,
+		Kind:    ArangoRestoreKind,
+		Version: ArangoRestoreVersionV1,
+	}
+}
+
+func ArangoRestoreGR() schema.GroupResource {
+	return schema.GroupResource{
+		Group:    ArangoRestoreGroup,
+		Resource: ArangoRestoreResource,
+	}
+}
+
+func ArangoRestoreGRv1() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    ArangoRestoreGroup,
+		Resource: ArangoRestoreResource,
+		Version:  ArangoRestoreVersionV1,
+	}
+}
+
+func (p *arangoRestoresInspectorV1) GroupVersionKind() schema.GroupVersionKind {
+	return ArangoRestoreGKv1()
+}
+
+func (p *arangoRestoresInspectorV1) GroupVersionResource() schema.GroupVersionResource {
+	return ArangoRestoreGRv1()
+}
+
+func (p *arangoRestoresInspector) GroupKind() schema.GroupKind {
+	return ArangoRestoreGK()
+}
+
+func (p *arangoRestoresInspector) GroupResource() schema.GroupResource {
+	return ArangoRestoreGR()
+}
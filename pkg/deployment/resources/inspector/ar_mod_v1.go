@@ -0,0 +1,89 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"context"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	arangoClient "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/typed/backup/v1"
+	arangorestorev1 "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangorestore/v1"
+)
+
+func (p arangoRestoresMod) V1() arangorestorev1.ModInterface {
+	return arangoRestoresModV1(p)
+}
+
+type arangoRestoresModV1 struct {
+	i *inspectorState
+}
+
+func (p arangoRestoresModV1) client() arangoClient.ArangoRestoreInterface {
+	return p.i.Client().Arango().BackupV1().ArangoRestores(p.i.Namespace())
+}
+
+func (p arangoRestoresModV1) Create(ctx context.Context, arangoRestore *api.ArangoRestore, opts meta.CreateOptions) (*api.ArangoRestore, error) {
+	if arangoRestore, err := p.client().Create(ctx, arangoRestore, opts); err != nil {
+		return arangoRestore, err
+	} else {
+		p.i.GetThrottles().ArangoRestore().Invalidate()
+		return arangoRestore, err
+	}
+}
+
+func (p arangoRestoresModV1) Update(ctx context.Context, arangoRestore *api.ArangoRestore, opts meta.UpdateOptions) (*api.ArangoRestore, error) {
+	if arangoRestore, err := p.client().Update(ctx, arangoRestore, opts); err != nil {
+		return arangoRestore, err
+	} else {
+		p.i.GetThrottles().ArangoRestore().Invalidate()
+		return arangoRestore, err
+	}
+}
+
+func (p arangoRestoresModV1) UpdateStatus(ctx context.Context, arangoRestore *api.ArangoRestore, opts meta.UpdateOptions) (*api.ArangoRestore, error) {
+	if arangoRestore, err := p.client().UpdateStatus(ctx, arangoRestore, opts); err != nil {
+		return arangoRestore, err
+	} else {
+		p.i.GetThrottles().ArangoRestore().Invalidate()
+		return arangoRestore, err
+	}
+}
+
+func (p arangoRestoresModV1) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts meta.PatchOptions, subresources ...string) (result *api.ArangoRestore, err error) {
+	if arangoRestore, err := p.client().Patch(ctx, name, pt, data, opts, subresources...); err != nil {
+		return arangoRestore, err
+	} else {
+		p.i.GetThrottles().ArangoRestore().Invalidate()
+		return arangoRestore, err
+	}
+}
+
+func (p arangoRestoresModV1) Delete(ctx context.Context, name string, opts meta.DeleteOptions) error {
+	if err := p.client().Delete(ctx, name, opts); err != nil {
+		return err
+	} else {
+		p.i.GetThrottles().ArangoRestore().Invalidate()
+		return err
+	}
+}
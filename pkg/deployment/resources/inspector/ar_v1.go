@@ -0,0 +1,137 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	"context"
+
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	ins "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangorestore/v1"
+)
+
+func (p *arangoRestoresInspector) V1() (ins.Inspector, error) {
+	if p.v1.err != nil {
+		return nil, p.v1.err
+	}
+
+	return p.v1, nil
+}
+
+type arangoRestoresInspectorV1 struct {
+	arangoRestoreInspector *arangoRestoresInspector
+
+	arangoRestores map[string]*api.ArangoRestore
+	err            error
+}
+
+func (p *arangoRestoresInspectorV1) Filter(filters ...ins.Filter) []*api.ArangoRestore {
+	z := p.ListSimple()
+
+	r := make([]*api.ArangoRestore, 0, len(z))
+
+	for _, o := range z {
+		if !ins.FilterObject(o, filters...) {
+			continue
+		}
+
+		r = append(r, o)
+	}
+
+	return r
+}
+
+func (p *arangoRestoresInspectorV1) validate() error {
+	if p == nil {
+		return errors.Newf("ArangoRestoresV1Inspector is nil")
+	}
+
+	if p.arangoRestoreInspector == nil {
+		return errors.Newf("Parent is nil")
+	}
+
+	if p.arangoRestores == nil && p.err == nil {
+		return errors.Newf("ArangoRestores or err should be not nil")
+	}
+
+	if p.arangoRestores != nil && p.err != nil {
+		return errors.Newf("ArangoRestores or err cannot be not nil together")
+	}
+
+	return nil
+}
+
+func (p *arangoRestoresInspectorV1) ListSimple() []*api.ArangoRestore {
+	var r []*api.ArangoRestore
+	for _, arangoRestore := range p.arangoRestores {
+		r = append(r, arangoRestore)
+	}
+
+	return r
+}
+
+func (p *arangoRestoresInspectorV1) GetSimple(name string) (*api.ArangoRestore, bool) {
+	arangoRestore, ok := p.arangoRestores[name]
+	if !ok {
+		return nil, false
+	}
+
+	return arangoRestore, true
+}
+
+func (p *arangoRestoresInspectorV1) Iterate(action ins.Action, filters ...ins.Filter) error {
+	for _, arangoRestore := range p.arangoRestores {
+		if err := p.iterateArangoRestore(arangoRestore, action, filters...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *arangoRestoresInspectorV1) iterateArangoRestore(arangoRestore *api.ArangoRestore, action ins.Action, filters ...ins.Filter) error {
+	for _, f := range filters {
+		if f == nil {
+			continue
+		}
+
+		if !f(arangoRestore) {
+			return nil
+		}
+	}
+
+	return action(arangoRestore)
+}
+
+func (p *arangoRestoresInspectorV1) Read() ins.ReadInterface {
+	return p
+}
+
+func (p *arangoRestoresInspectorV1) Get(ctx context.Context, name string, opts meta.GetOptions) (*api.ArangoRestore, error) {
+	if s, ok := p.GetSimple(name); !ok {
+		return nil, apiErrors.NewNotFound(ArangoRestoreGR(), name)
+	} else {
+		return s, nil
+	}
+}
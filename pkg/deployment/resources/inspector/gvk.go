@@ -27,6 +27,7 @@ import (
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 )
 
@@ -37,8 +38,12 @@ func ExtractGVKFromObject(in interface{}) (schema.GroupVersionKind, bool) {
 			return ArangoClusterSynchronizationGKv1(), true
 		case *api.ArangoMember, api.ArangoMember:
 			return ArangoMemberGKv1(), true
+		case *api.ArangoMemberGroup, api.ArangoMemberGroup:
+			return ArangoMemberGroupGKv1(), true
 		case *api.ArangoTask, api.ArangoTask:
 			return ArangoTaskGKv1(), true
+		case *backupApi.ArangoRestore, backupApi.ArangoRestore:
+			return ArangoRestoreGKv1(), true
 		case *core.Endpoints, core.Endpoints:
 			return EndpointsGKv1(), true
 		case *core.Node, core.Node:
@@ -31,13 +31,16 @@ import (
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 )
 
 func Test_GVK(t *testing.T) {
 	testGVK(t, ArangoClusterSynchronizationGKv1(), &api.ArangoClusterSynchronization{}, api.ArangoClusterSynchronization{})
 	testGVK(t, ArangoMemberGKv1(), &api.ArangoMember{}, api.ArangoMember{})
+	testGVK(t, ArangoMemberGroupGKv1(), &api.ArangoMemberGroup{}, api.ArangoMemberGroup{})
 	testGVK(t, ArangoTaskGKv1(), &api.ArangoTask{}, api.ArangoTask{})
+	testGVK(t, ArangoRestoreGKv1(), &backupApi.ArangoRestore{}, backupApi.ArangoRestore{})
 	testGVK(t, EndpointsGKv1(), &core.Endpoints{}, core.Endpoints{})
 	testGVK(t, NodeGKv1(), &core.Node{}, core.Node{})
 	testGVK(t, PodDisruptionBudgetGKv1(), &policyv1.PodDisruptionBudget{}, policyv1.PodDisruptionBudget{})
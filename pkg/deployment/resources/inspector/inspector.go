@@ -40,6 +40,8 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/anonymous"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangoclustersynchronization"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangomember"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangomembergroup"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangorestore"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangotask"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/endpoints"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/node"
@@ -158,6 +160,8 @@ type inspectorState struct {
 	serviceMonitors               *serviceMonitorsInspector
 	arangoMembers                 *arangoMembersInspector
 	arangoTasks                   *arangoTasksInspector
+	arangoMemberGroups            *arangoMemberGroupsInspector
+	arangoRestores                *arangoRestoresInspector
 	arangoClusterSynchronizations *arangoClusterSynchronizationsInspector
 	endpoints                     *endpointsInspector
 
@@ -220,6 +224,8 @@ func (i *inspectorState) AnonymousObjects() []anonymous.Impl {
 		i.serviceMonitors,
 		i.arangoMembers,
 		i.arangoTasks,
+		i.arangoMemberGroups,
+		i.arangoRestores,
 		i.arangoClusterSynchronizations,
 		i.endpoints,
 	}
@@ -308,6 +314,14 @@ func (i *inspectorState) ArangoTask() arangotask.Definition {
 	return i.arangoTasks
 }
 
+func (i *inspectorState) ArangoMemberGroup() arangomembergroup.Definition {
+	return i.arangoMemberGroups
+}
+
+func (i *inspectorState) ArangoRestore() arangorestore.Definition {
+	return i.arangoRestores
+}
+
 func (i *inspectorState) Refresh(ctx context.Context) error {
 	return i.refresh(ctx, inspectorLoadersList...)
 }
@@ -453,6 +467,14 @@ func (i *inspectorState) validate() error {
 		return err
 	}
 
+	if err := i.arangoMemberGroups.validate(); err != nil {
+		return err
+	}
+
+	if err := i.arangoRestores.validate(); err != nil {
+		return err
+	}
+
 	if err := i.arangoClusterSynchronizations.validate(); err != nil {
 		return err
 	}
@@ -479,6 +501,8 @@ func (i *inspectorState) copyCore() *inspectorState {
 		serviceMonitors:               i.serviceMonitors,
 		arangoMembers:                 i.arangoMembers,
 		arangoTasks:                   i.arangoTasks,
+		arangoMemberGroups:            i.arangoMemberGroups,
+		arangoRestores:                i.arangoRestores,
 		arangoClusterSynchronizations: i.arangoClusterSynchronizations,
 		throttles:                     i.throttles.Copy(),
 		versionInfo:                   i.versionInfo,
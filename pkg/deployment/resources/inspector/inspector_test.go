@@ -103,6 +103,22 @@ var loaderTestDefinitions = map[string]loaderTestDefinition{
 			return i.ArangoMember()
 		},
 	},
+	"ArangoMemberGroup": {
+		tg: func(t throttle.Components) throttle.Throttle {
+			return t.ArangoMemberGroup()
+		},
+		get: func(i inspector.Inspector) refresh.Inspector {
+			return i.ArangoMemberGroup()
+		},
+	},
+	"ArangoRestore": {
+		tg: func(t throttle.Components) throttle.Throttle {
+			return t.ArangoRestore()
+		},
+		get: func(i inspector.Inspector) refresh.Inspector {
+			return i.ArangoRestore()
+		},
+	},
 	"ArangoTask": {
 		tg: func(t throttle.Components) throttle.Throttle {
 			return t.ArangoTask()
@@ -134,7 +150,7 @@ func getAllTypes() []string {
 func Test_Inspector_RefreshMatrix(t *testing.T) {
 	c := kclient.NewFakeClient()
 
-	tc := throttle.NewThrottleComponents(time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour)
+	tc := throttle.NewThrottleComponents(time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour)
 
 	i := NewInspector(tc, c, "test", "test")
 
@@ -294,7 +310,7 @@ func Test_Inspector_Load(t *testing.T) {
 func Test_Inspector_Invalidate(t *testing.T) {
 	c := kclient.NewFakeClient()
 
-	tc := throttle.NewThrottleComponents(time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour)
+	tc := throttle.NewThrottleComponents(time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour)
 
 	i := NewInspector(tc, c, "test", "test")
 
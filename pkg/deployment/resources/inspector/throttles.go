@@ -30,6 +30,8 @@ func NewDefaultThrottle() throttle.Components {
 	return throttle.NewThrottleComponents(
 		30*time.Second, // ArangoDeploymentSynchronization
 		30*time.Second, // ArangoMember
+		15*time.Second, // ArangoMemberGroup
+		15*time.Second, // ArangoRestore
 		30*time.Second, // ArangoTask
 		30*time.Second, // Node
 		15*time.Second, // PVC
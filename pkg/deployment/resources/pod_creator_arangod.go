@@ -526,6 +526,8 @@ func (m *MemberArangoDPod) ApplyPodSpec(p *core.PodSpec) error {
 		p.SchedulerName = *s
 	}
 
+	pod.AppendTopologySpreadConstraints(m, p, m.groupSpec.GetTopologySpreadConstraints())
+
 	return nil
 }
 
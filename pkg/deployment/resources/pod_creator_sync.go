@@ -376,6 +376,8 @@ func (m *MemberSyncPod) ApplyPodSpec(spec *core.PodSpec) error {
 		spec.SchedulerName = *s
 	}
 
+	pod.AppendTopologySpreadConstraints(m, spec, m.groupSpec.GetTopologySpreadConstraints())
+
 	return nil
 }
 
@@ -65,6 +65,27 @@ func affinityCompare(_ api.DeploymentSpec, _ api.ServerGroup, spec, status *core
 	}
 }
 
+func topologySpreadConstraintsCompare(_ api.DeploymentSpec, _ api.ServerGroup, spec, status *core.PodSpec) comparePodFunc {
+	return func(builder api.ActionBuilder) (mode Mode, plan api.Plan, e error) {
+		if specC, err := util.SHA256FromJSON(spec.TopologySpreadConstraints); err != nil {
+			e = err
+			return
+		} else {
+			if statusC, err := util.SHA256FromJSON(status.TopologySpreadConstraints); err != nil {
+				e = err
+				return
+			} else if specC != statusC {
+				// Constraints are evaluated only during scheduling, so pod needs to be recreated
+				mode = mode.And(GracefulRotation)
+				status.TopologySpreadConstraints = spec.TopologySpreadConstraints
+				return
+			} else {
+				return
+			}
+		}
+	}
+}
+
 func getRotationMode(spec, status *core.PodSpec) Mode {
 	var specArchs map[api.ArangoDeploymentArchitectureType]bool
 	var statusArchs map[api.ArangoDeploymentArchitectureType]bool
@@ -495,3 +495,70 @@ func Test_ArangoD_Envs_Zone(t *testing.T) {
 
 	runTestCases(t)(testCases...)
 }
+
+func Test_ArangoD_TopologySpreadConstraints(t *testing.T) {
+	constraint := func(maxSkew int32) core.TopologySpreadConstraint {
+		return core.TopologySpreadConstraint{
+			MaxSkew:           maxSkew,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: core.DoNotSchedule,
+		}
+	}
+
+	testCases := []TestCase{
+		{
+			name: "Add",
+			spec: buildPodSpec(func(pod *core.PodTemplateSpec) {
+				pod.Spec.TopologySpreadConstraints = []core.TopologySpreadConstraint{constraint(1)}
+			}),
+			status: buildPodSpec(func(pod *core.PodTemplateSpec) {
+				pod.Spec.TopologySpreadConstraints = nil
+			}),
+
+			TestCaseOverride: TestCaseOverride{
+				expectedMode: GracefulRotation,
+			},
+		},
+		{
+			name: "Remove",
+			spec: buildPodSpec(func(pod *core.PodTemplateSpec) {
+				pod.Spec.TopologySpreadConstraints = nil
+			}),
+			status: buildPodSpec(func(pod *core.PodTemplateSpec) {
+				pod.Spec.TopologySpreadConstraints = []core.TopologySpreadConstraint{constraint(1)}
+			}),
+
+			TestCaseOverride: TestCaseOverride{
+				expectedMode: GracefulRotation,
+			},
+		},
+		{
+			name: "Update",
+			spec: buildPodSpec(func(pod *core.PodTemplateSpec) {
+				pod.Spec.TopologySpreadConstraints = []core.TopologySpreadConstraint{constraint(2)}
+			}),
+			status: buildPodSpec(func(pod *core.PodTemplateSpec) {
+				pod.Spec.TopologySpreadConstraints = []core.TopologySpreadConstraint{constraint(1)}
+			}),
+
+			TestCaseOverride: TestCaseOverride{
+				expectedMode: GracefulRotation,
+			},
+		},
+		{
+			name: "Equals",
+			spec: buildPodSpec(func(pod *core.PodTemplateSpec) {
+				pod.Spec.TopologySpreadConstraints = []core.TopologySpreadConstraint{constraint(1)}
+			}),
+			status: buildPodSpec(func(pod *core.PodTemplateSpec) {
+				pod.Spec.TopologySpreadConstraints = []core.TopologySpreadConstraint{constraint(1)}
+			}),
+
+			TestCaseOverride: TestCaseOverride{
+				expectedMode: SkippedRotation,
+			},
+		},
+	}
+
+	runTestCases(t)(testCases...)
+}
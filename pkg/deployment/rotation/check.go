@@ -42,6 +42,21 @@ const (
 	EnforcedRotation
 )
 
+func (m Mode) String() string {
+	switch m {
+	case SilentRotation:
+		return "Silent"
+	case InPlaceRotation:
+		return "InPlace"
+	case GracefulRotation:
+		return "Graceful"
+	case EnforcedRotation:
+		return "Enforced"
+	default:
+		return "Skipped"
+	}
+}
+
 // And returns the higher value of the rotation mode.
 func (m Mode) And(b Mode) Mode {
 	if m > b {
@@ -93,7 +93,7 @@ func compare(deploymentSpec api.DeploymentSpec, member api.MemberStatus, group a
 
 	g := podFuncGenerator(deploymentSpec, group, &spec.PodSpec.Spec, &podStatus.Spec)
 
-	if m, p, err := comparePod(b, g(podCompare), g(affinityCompare), g(comparePodVolumes), g(containersCompare), g(initContainersCompare)); err != nil {
+	if m, p, err := comparePod(b, g(podCompare), g(affinityCompare), g(topologySpreadConstraintsCompare), g(comparePodVolumes), g(containersCompare), g(initContainersCompare)); err != nil {
 		log.Err(err).Msg("Error while getting pod diff")
 		return SkippedRotation, nil, err
 	} else {
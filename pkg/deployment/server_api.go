@@ -23,14 +23,17 @@ package deployment
 import (
 	"context"
 	"sort"
+	"strings"
 
 	core "k8s.io/api/core/v1"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/apis/shared"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
 	memberState "github.com/arangodb/kube-arangodb/pkg/deployment/member"
 	"github.com/arangodb/kube-arangodb/pkg/server"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
@@ -226,3 +229,71 @@ func (d *Deployment) Members() map[api.ServerGroup][]server.Member {
 
 	return result
 }
+
+// PlanHistory returns the latest actions executed by the operator.
+func (d *Deployment) PlanHistory() api.PlanHistory {
+	return d.GetStatus().PlanHistory
+}
+
+// PreviewPlan returns the plan which would be created for the given spec.
+// Spec is defaulted and validated in the same way as during acceptance, but it is not applied.
+func (d *Deployment) PreviewPlan(ctx context.Context, spec api.DeploymentSpec) (server.PlanPreview, error) {
+	proposed := spec.DeepCopy()
+	proposed.SetDefaults(d.name)
+
+	accepted := d.GetStatus().AcceptedSpec
+	if accepted != nil && features.DeploymentSpecDefaultsRestore().Enabled() {
+		proposed.SetDefaultsFrom(*accepted)
+	}
+
+	if err := proposed.Validate(); err != nil {
+		return server.PlanPreview{}, err
+	}
+
+	if accepted != nil {
+		if fields := accepted.ResetImmutableFields(proposed.DeepCopy()); len(fields) > 0 {
+			return server.PlanPreview{}, errors.Newf("Immutable fields cannot be changed: %s", strings.Join(fields, ", "))
+		}
+	}
+
+	preview, err := d.reconciler.PreviewPlan(ctx, *proposed)
+	if err != nil {
+		return server.PlanPreview{}, err
+	}
+
+	result := server.PlanPreview{
+		High:      newPlanPreviewActions(preview.High),
+		Resources: newPlanPreviewActions(preview.Resources),
+		Normal:    newPlanPreviewActions(preview.Normal),
+	}
+
+	for _, m := range preview.Members {
+		result.Members = append(result.Members, server.PlanPreviewMember{
+			Group:    m.Group.AsRole(),
+			ID:       m.ID,
+			Rotation: m.Mode.String(),
+			Reason:   m.Reason,
+			Actions:  newPlanPreviewActions(m.Plan),
+		})
+	}
+
+	return result, nil
+}
+
+func newPlanPreviewActions(plan api.Plan) []server.PlanPreviewAction {
+	if len(plan) == 0 {
+		return nil
+	}
+
+	result := make([]server.PlanPreviewAction, len(plan))
+	for id, a := range plan {
+		result[id] = server.PlanPreviewAction{
+			Type:     a.Type.String(),
+			Group:    a.Group.AsRole(),
+			MemberID: a.MemberID,
+			Reason:   a.Reason,
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,107 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package topology
+
+import (
+	core "k8s.io/api/core/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+)
+
+// PodGetter returns Pod of the member, nil if it does not exist
+type PodGetter func(m api.MemberStatus) (*core.Pod, error)
+
+// NodeGetter returns Node on which member is running, nil if it does not exist
+type NodeGetter func(m api.MemberStatus, name string) (*core.Node, error)
+
+// ServerLocation keeps node and zone of the server
+type ServerLocation struct {
+	Node string
+	Zone string
+}
+
+// ServerLocations keeps location of each server
+type ServerLocations map[agency.Server]ServerLocation
+
+// Zones returns known zones of the servers
+func (s ServerLocations) Zones() agency.ServerZones {
+	zones := agency.ServerZones{}
+
+	for server, l := range s {
+		if l.Zone != "" {
+			zones[server] = l.Zone
+		}
+	}
+
+	return zones
+}
+
+// Nodes returns nodes of the servers, empty if node of the server is not known
+func (s ServerLocations) Nodes(servers agency.Servers) []string {
+	r := make([]string, len(servers))
+
+	for id, server := range servers {
+		r[id] = s[server].Node
+	}
+
+	return r
+}
+
+// GetServerLocations returns nodes and zones of the members. Zone is taken from the label of the node
+// on which member is running, or from the topology zone assigned to the member if it is not known.
+func GetServerLocations(members api.MemberStatusList, label string, pods PodGetter, nodes NodeGetter) (ServerLocations, error) {
+	locations := ServerLocations{}
+
+	for _, m := range members {
+		var l ServerLocation
+
+		if m.Pod.GetName() != "" {
+			pod, err := pods(m)
+			if err != nil {
+				return nil, err
+			}
+
+			if pod != nil && pod.Spec.NodeName != "" {
+				l.Node = pod.Spec.NodeName
+
+				node, err := nodes(m, pod.Spec.NodeName)
+				if err != nil {
+					return nil, err
+				}
+
+				if node != nil {
+					l.Zone = node.Labels[label]
+				}
+			}
+		}
+
+		if l.Zone == "" && m.Topology != nil {
+			l.Zone = m.Topology.Label
+		}
+
+		if l.Node != "" || l.Zone != "" {
+			locations[agency.Server(m.ID)] = l
+		}
+	}
+
+	return locations, nil
+}
@@ -0,0 +1,68 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package topology
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/agency"
+)
+
+func Test_GetServerLocations(t *testing.T) {
+	members := api.MemberStatusList{
+		{ID: "A", Pod: &api.MemberPodStatus{Name: "pod-a"}},
+		{ID: "B", Pod: &api.MemberPodStatus{Name: "pod-b"}, Topology: &api.TopologyMemberStatus{Label: "zone-b"}},
+		{ID: "C", Topology: &api.TopologyMemberStatus{Label: "zone-c"}},
+		{ID: "D"},
+	}
+
+	pods := map[string]*core.Pod{
+		"pod-a": {Spec: core.PodSpec{NodeName: "node-a"}},
+		"pod-b": {Spec: core.PodSpec{NodeName: "node-b"}},
+	}
+
+	nodes := map[string]*core.Node{
+		"node-a": {ObjectMeta: meta.ObjectMeta{Labels: map[string]string{"zone": "zone-a"}}},
+	}
+
+	locations, err := GetServerLocations(members, "zone",
+		func(m api.MemberStatus) (*core.Pod, error) {
+			return pods[m.Pod.GetName()], nil
+		},
+		func(_ api.MemberStatus, name string) (*core.Node, error) {
+			return nodes[name], nil
+		})
+	require.NoError(t, err)
+
+	require.Equal(t, ServerLocations{
+		"A": {Node: "node-a", Zone: "zone-a"},
+		"B": {Node: "node-b", Zone: "zone-b"},
+		"C": {Zone: "zone-c"},
+	}, locations)
+
+	require.Equal(t, agency.ServerZones{"A": "zone-a", "B": "zone-b", "C": "zone-c"}, locations.Zones())
+	require.Equal(t, []string{"node-a", "", ""}, locations.Nodes(agency.Servers{"A", "C", "D"}))
+}
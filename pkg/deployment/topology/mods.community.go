@@ -21,8 +21,28 @@
 
 package topology
 
-import api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+import (
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
 
+// WithTopologyMod assigns new member to the least used zone
 func WithTopologyMod(s *api.DeploymentStatus, g api.ServerGroup, m *api.MemberStatus) error {
+	if !s.Topology.Enabled() || !IsTopologyAwareGroup(g) {
+		return nil
+	}
+
+	zone := s.Topology.GetLeastUsedZone(g)
+	if zone < 0 {
+		return errors.Newf("Unable to find zone for member %s", m.ID)
+	}
+
+	s.Topology.Zones[zone].AddMember(g, m.ID)
+
+	m.Topology = &api.TopologyMemberStatus{
+		ID:   s.Topology.ID,
+		Zone: zone,
+	}
+
 	return nil
 }
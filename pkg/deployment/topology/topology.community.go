@@ -22,11 +22,101 @@
 package topology
 
 import (
+	"strconv"
+
 	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
+// IsTopologyAwareGroup returns true if members of the group are spread across zones
+func IsTopologyAwareGroup(group api.ServerGroup) bool {
+	switch group {
+	case api.ServerGroupAgents, api.ServerGroupDBServers, api.ServerGroupCoordinators:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetTopologyAffinityRules returns affinity which keeps member in the physical zone (defined by the topology label)
+// of its zone. Pods of the other zones are excluded from the physical zone of the member.
 func GetTopologyAffinityRules(name string, status api.DeploymentStatus, group api.ServerGroup, member api.MemberStatus) core.Affinity {
-	return core.Affinity{}
+	t := status.Topology
+
+	if !IsTopologyAwareGroup(group) || !t.Enabled() || !t.IsTopologyOwned(member.Topology) {
+		return core.Affinity{}
+	}
+
+	var r core.Affinity
+
+	zone := member.Topology.Zone
+
+	var otherZones []string
+
+	for i := range t.Zones {
+		if i == zone {
+			continue
+		}
+
+		otherZones = append(otherZones, strconv.Itoa(i))
+	}
+
+	if len(otherZones) > 0 {
+		r.PodAntiAffinity = &core.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []core.PodAffinityTerm{
+				topologyPodAffinityTerm(name, t, otherZones...),
+			},
+		}
+	}
+
+	if zone >= 0 && zone < len(t.Zones) && len(t.Zones[zone].Labels) > 0 {
+		// Zone is bound to the physical zones. Physical zones of the other zones are excluded by the In requirement
+		term := core.NodeSelectorTerm{
+			MatchExpressions: []core.NodeSelectorRequirement{
+				{
+					Key:      t.Label,
+					Operator: core.NodeSelectorOpIn,
+					Values:   t.Zones[zone].Labels.Sort(),
+				},
+			},
+		}
+
+		r.NodeAffinity = &core.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &core.NodeSelector{
+				NodeSelectorTerms: []core.NodeSelectorTerm{term},
+			},
+		}
+	} else {
+		r.PodAffinity = &core.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []core.PodAffinityTerm{
+				topologyPodAffinityTerm(name, t, strconv.Itoa(zone)),
+			},
+		}
+	}
+
+	return r
+}
+
+func topologyPodAffinityTerm(name string, t *api.TopologyStatus, zones ...string) core.PodAffinityTerm {
+	return core.PodAffinityTerm{
+		LabelSelector: &meta.LabelSelector{
+			MatchLabels: k8sutil.LabelsForDeployment(name, ""),
+			MatchExpressions: []meta.LabelSelectorRequirement{
+				{
+					Key:      k8sutil.LabelKeyArangoTopology,
+					Operator: meta.LabelSelectorOpIn,
+					Values:   []string{string(t.ID)},
+				},
+				{
+					Key:      k8sutil.LabelKeyArangoZone,
+					Operator: meta.LabelSelectorOpIn,
+					Values:   zones,
+				},
+			},
+		},
+		TopologyKey: t.Label,
+	}
 }
@@ -0,0 +1,96 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//go:build !enterprise
+// +build !enterprise
+
+package topology
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func Test_GetTopologyAffinityRules(t *testing.T) {
+	newStatus := func(labels ...api.List) api.DeploymentStatus {
+		status := api.NewTopologyStatus(&api.TopologySpec{Zones: len(labels), Label: util.NewString("zone")})
+		for id := range labels {
+			status.Zones[id].Labels = labels[id]
+		}
+
+		return api.DeploymentStatus{Topology: status}
+	}
+
+	newMember := func(status api.DeploymentStatus, zone int) api.MemberStatus {
+		return api.MemberStatus{
+			ID: "A",
+			Topology: &api.TopologyMemberStatus{
+				ID:   status.Topology.ID,
+				Zone: zone,
+			},
+		}
+	}
+
+	t.Run("Not owned", func(t *testing.T) {
+		status := newStatus(api.List{"a"}, api.List{"b"})
+
+		r := GetTopologyAffinityRules("depl", status, api.ServerGroupDBServers, api.MemberStatus{ID: "A"})
+
+		require.Equal(t, core.Affinity{}, r)
+	})
+
+	t.Run("Zone with physical zones", func(t *testing.T) {
+		status := newStatus(api.List{"b", "a"}, api.List{"c"}, api.List{"d"})
+
+		r := GetTopologyAffinityRules("depl", status, api.ServerGroupDBServers, newMember(status, 0))
+
+		require.NotNil(t, r.NodeAffinity)
+		require.NotNil(t, r.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+
+		// Terms are ORed, so all requirements need to be defined in the single term
+		terms := r.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+		require.Len(t, terms, 1)
+		require.Equal(t, []core.NodeSelectorRequirement{
+			{
+				Key:      "zone",
+				Operator: core.NodeSelectorOpIn,
+				Values:   []string{"a", "b"},
+			},
+		}, terms[0].MatchExpressions)
+
+		require.NotNil(t, r.PodAntiAffinity)
+		require.Len(t, r.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, 1)
+		require.Equal(t, "zone", r.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution[0].TopologyKey)
+		require.Nil(t, r.PodAffinity)
+	})
+
+	t.Run("Zone without physical zones", func(t *testing.T) {
+		status := newStatus(nil, api.List{"c"})
+
+		r := GetTopologyAffinityRules("depl", status, api.ServerGroupDBServers, newMember(status, 0))
+
+		require.Nil(t, r.NodeAffinity)
+		require.NotNil(t, r.PodAffinity)
+		require.Len(t, r.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, 1)
+	})
+}
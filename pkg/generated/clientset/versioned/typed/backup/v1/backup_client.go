@@ -32,6 +32,7 @@ type BackupV1Interface interface {
 	RESTClient() rest.Interface
 	ArangoBackupsGetter
 	ArangoBackupPoliciesGetter
+	ArangoRestoresGetter
 }
 
 // BackupV1Client is used to interact with features provided by the backup.arangodb.com group.
@@ -47,6 +48,10 @@ func (c *BackupV1Client) ArangoBackupPolicies(namespace string) ArangoBackupPoli
 	return newArangoBackupPolicies(c, namespace)
 }
 
+func (c *BackupV1Client) ArangoRestores(namespace string) ArangoRestoreInterface {
+	return newArangoRestores(c, namespace)
+}
+
 // NewForConfig creates a new BackupV1Client for the given config.
 func NewForConfig(c *rest.Config) (*BackupV1Client, error) {
 	config := *c
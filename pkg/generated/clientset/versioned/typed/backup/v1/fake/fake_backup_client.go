@@ -40,6 +40,10 @@ func (c *FakeBackupV1) ArangoBackupPolicies(namespace string) v1.ArangoBackupPol
 	return &FakeArangoBackupPolicies{c, namespace}
 }
 
+func (c *FakeBackupV1) ArangoRestores(namespace string) v1.ArangoRestoreInterface {
+	return &FakeArangoRestores{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeBackupV1) RESTClient() rest.Interface {
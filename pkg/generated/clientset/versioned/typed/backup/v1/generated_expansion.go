@@ -25,3 +25,5 @@ package v1
 type ArangoBackupExpansion interface{}
 
 type ArangoBackupPolicyExpansion interface{}
+
+type ArangoRestoreExpansion interface{}
@@ -33,6 +33,7 @@ type DatabaseV1Interface interface {
 	ArangoClusterSynchronizationsGetter
 	ArangoDeploymentsGetter
 	ArangoMembersGetter
+	ArangoMemberGroupsGetter
 	ArangoTasksGetter
 }
 
@@ -53,6 +54,10 @@ func (c *DatabaseV1Client) ArangoMembers(namespace string) ArangoMemberInterface
 	return newArangoMembers(c, namespace)
 }
 
+func (c *DatabaseV1Client) ArangoMemberGroups(namespace string) ArangoMemberGroupInterface {
+	return newArangoMemberGroups(c, namespace)
+}
+
 func (c *DatabaseV1Client) ArangoTasks(namespace string) ArangoTaskInterface {
 	return newArangoTasks(c, namespace)
 }
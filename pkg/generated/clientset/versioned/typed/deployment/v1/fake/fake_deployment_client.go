@@ -44,6 +44,10 @@ func (c *FakeDatabaseV1) ArangoMembers(namespace string) v1.ArangoMemberInterfac
 	return &FakeArangoMembers{c, namespace}
 }
 
+func (c *FakeDatabaseV1) ArangoMemberGroups(namespace string) v1.ArangoMemberGroupInterface {
+	return &FakeArangoMemberGroups{c, namespace}
+}
+
 func (c *FakeDatabaseV1) ArangoTasks(namespace string) v1.ArangoTaskInterface {
 	return &FakeArangoTasks{c, namespace}
 }
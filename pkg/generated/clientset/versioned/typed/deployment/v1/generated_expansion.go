@@ -28,4 +28,6 @@ type ArangoDeploymentExpansion interface{}
 
 type ArangoMemberExpansion interface{}
 
+type ArangoMemberGroupExpansion interface{}
+
 type ArangoTaskExpansion interface{}
@@ -33,6 +33,7 @@ type DatabaseV2alpha1Interface interface {
 	ArangoClusterSynchronizationsGetter
 	ArangoDeploymentsGetter
 	ArangoMembersGetter
+	ArangoMemberGroupsGetter
 	ArangoTasksGetter
 }
 
@@ -53,6 +54,10 @@ func (c *DatabaseV2alpha1Client) ArangoMembers(namespace string) ArangoMemberInt
 	return newArangoMembers(c, namespace)
 }
 
+func (c *DatabaseV2alpha1Client) ArangoMemberGroups(namespace string) ArangoMemberGroupInterface {
+	return newArangoMemberGroups(c, namespace)
+}
+
 func (c *DatabaseV2alpha1Client) ArangoTasks(namespace string) ArangoTaskInterface {
 	return newArangoTasks(c, namespace)
 }
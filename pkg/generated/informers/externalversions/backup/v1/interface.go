@@ -32,6 +32,8 @@ type Interface interface {
 	ArangoBackups() ArangoBackupInformer
 	// ArangoBackupPolicies returns a ArangoBackupPolicyInformer.
 	ArangoBackupPolicies() ArangoBackupPolicyInformer
+	// ArangoRestores returns a ArangoRestoreInformer.
+	ArangoRestores() ArangoRestoreInformer
 }
 
 type version struct {
@@ -54,3 +56,8 @@ func (v *version) ArangoBackups() ArangoBackupInformer {
 func (v *version) ArangoBackupPolicies() ArangoBackupPolicyInformer {
 	return &arangoBackupPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ArangoRestores returns a ArangoRestoreInformer.
+func (v *version) ArangoRestores() ArangoRestoreInformer {
+	return &arangoRestoreInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
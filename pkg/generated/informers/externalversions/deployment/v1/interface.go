@@ -34,6 +34,8 @@ type Interface interface {
 	ArangoDeployments() ArangoDeploymentInformer
 	// ArangoMembers returns a ArangoMemberInformer.
 	ArangoMembers() ArangoMemberInformer
+	// ArangoMemberGroups returns a ArangoMemberGroupInformer.
+	ArangoMemberGroups() ArangoMemberGroupInformer
 	// ArangoTasks returns a ArangoTaskInformer.
 	ArangoTasks() ArangoTaskInformer
 }
@@ -64,6 +66,11 @@ func (v *version) ArangoMembers() ArangoMemberInformer {
 	return &arangoMemberInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ArangoMemberGroups returns a ArangoMemberGroupInformer.
+func (v *version) ArangoMemberGroups() ArangoMemberGroupInformer {
+	return &arangoMemberGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ArangoTasks returns a ArangoTaskInformer.
 func (v *version) ArangoTasks() ArangoTaskInformer {
 	return &arangoTaskInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
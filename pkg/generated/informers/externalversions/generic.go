@@ -71,6 +71,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Backup().V1().ArangoBackups().Informer()}, nil
 	case backupv1.SchemeGroupVersion.WithResource("arangobackuppolicies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Backup().V1().ArangoBackupPolicies().Informer()}, nil
+	case backupv1.SchemeGroupVersion.WithResource("arangorestores"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Backup().V1().ArangoRestores().Informer()}, nil
 
 		// Group=database.arangodb.com, Version=v1
 	case deploymentv1.SchemeGroupVersion.WithResource("arangoclustersynchronizations"):
@@ -79,6 +81,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V1().ArangoDeployments().Informer()}, nil
 	case deploymentv1.SchemeGroupVersion.WithResource("arangomembers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V1().ArangoMembers().Informer()}, nil
+	case deploymentv1.SchemeGroupVersion.WithResource("arangomembergroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V1().ArangoMemberGroups().Informer()}, nil
 	case deploymentv1.SchemeGroupVersion.WithResource("arangotasks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V1().ArangoTasks().Informer()}, nil
 
@@ -89,6 +93,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V2alpha1().ArangoDeployments().Informer()}, nil
 	case v2alpha1.SchemeGroupVersion.WithResource("arangomembers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V2alpha1().ArangoMembers().Informer()}, nil
+	case v2alpha1.SchemeGroupVersion.WithResource("arangomembergroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V2alpha1().ArangoMemberGroups().Informer()}, nil
 	case v2alpha1.SchemeGroupVersion.WithResource("arangotasks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Database().V2alpha1().ArangoTasks().Informer()}, nil
 
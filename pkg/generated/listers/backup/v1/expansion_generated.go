@@ -37,3 +37,11 @@ type ArangoBackupPolicyListerExpansion interface{}
 // ArangoBackupPolicyNamespaceListerExpansion allows custom methods to be added to
 // ArangoBackupPolicyNamespaceLister.
 type ArangoBackupPolicyNamespaceListerExpansion interface{}
+
+// ArangoRestoreListerExpansion allows custom methods to be added to
+// ArangoRestoreLister.
+type ArangoRestoreListerExpansion interface{}
+
+// ArangoRestoreNamespaceListerExpansion allows custom methods to be added to
+// ArangoRestoreNamespaceLister.
+type ArangoRestoreNamespaceListerExpansion interface{}
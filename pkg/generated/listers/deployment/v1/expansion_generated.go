@@ -46,6 +46,14 @@ type ArangoMemberListerExpansion interface{}
 // ArangoMemberNamespaceLister.
 type ArangoMemberNamespaceListerExpansion interface{}
 
+// ArangoMemberGroupListerExpansion allows custom methods to be added to
+// ArangoMemberGroupLister.
+type ArangoMemberGroupListerExpansion interface{}
+
+// ArangoMemberGroupNamespaceListerExpansion allows custom methods to be added to
+// ArangoMemberGroupNamespaceLister.
+type ArangoMemberGroupNamespaceListerExpansion interface{}
+
 // ArangoTaskListerExpansion allows custom methods to be added to
 // ArangoTaskLister.
 type ArangoTaskListerExpansion interface{}
@@ -0,0 +1,39 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package metric_descriptions
+
+import "github.com/arangodb/kube-arangodb/pkg/util/metrics"
+
+var (
+	arangodbOperatorBackupTransferDbserverLastUpdate = metrics.NewDescription("arangodb_operator_backup_transfer_dbserver_last_update", "Unix timestamp of the last transfer progress change on the DBServer", []string{`namespace`, `name`, `operation`, `dbserver`}, nil)
+)
+
+func init() {
+	registerDescription(arangodbOperatorBackupTransferDbserverLastUpdate)
+}
+
+func ArangodbOperatorBackupTransferDbserverLastUpdate() metrics.Description {
+	return arangodbOperatorBackupTransferDbserverLastUpdate
+}
+
+func ArangodbOperatorBackupTransferDbserverLastUpdateGauge(value float64, namespace string, name string, operation string, dbserver string) metrics.Metric {
+	return ArangodbOperatorBackupTransferDbserverLastUpdate().Gauge(value, namespace, name, operation, dbserver)
+}
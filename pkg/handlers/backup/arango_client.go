@@ -48,6 +48,15 @@ type ArangoBackupProgress struct {
 	Progress          int
 	Failed, Completed bool
 	FailMessage       string
+
+	DBServers map[string]ArangoBackupTransferProgress
+}
+
+// ArangoBackupTransferProgress progress info of the single DBServer
+type ArangoBackupTransferProgress struct {
+	Status      driver.BackupTransferStatus
+	Done, Total int
+	Message     string
 }
 
 // ArangoBackupCreateResponse create response
@@ -71,4 +80,7 @@ type ArangoBackupClient interface {
 	Delete(driver.BackupID) error
 
 	List() (map[driver.BackupID]driver.BackupMeta, error)
+
+	// CollectionCount returns number of documents in the collection, used by the backup verification
+	CollectionCount(database, collection string) (int64, error)
 }
@@ -197,15 +197,24 @@ func (ac *arangoClientBackupImpl) Progress(jobID driver.BackupTransferJobID) (Ar
 		}, nil
 	}
 
-	var ret ArangoBackupProgress
+	ret := ArangoBackupProgress{
+		DBServers: make(map[string]ArangoBackupTransferProgress, len(report.DBServers)),
+	}
 	var completedCount int
 	var total int
 	var done int
 
-	for _, status := range report.DBServers {
+	for id, status := range report.DBServers {
 		total += status.Progress.Total
 		done += status.Progress.Done
 
+		ret.DBServers[id] = ArangoBackupTransferProgress{
+			Status:  status.Status,
+			Done:    status.Progress.Done,
+			Total:   status.Progress.Total,
+			Message: status.ErrorMessage,
+		}
+
 		switch status.Status {
 		case driver.TransferFailed:
 			ret.Failed = true
@@ -255,3 +264,20 @@ func (ac *arangoClientBackupImpl) Abort(jobID driver.BackupTransferJobID) error
 
 	return ac.driver.Backup().Abort(ctx, jobID)
 }
+
+func (ac *arangoClientBackupImpl) CollectionCount(database, collection string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultArangoClientTimeout)
+	defer cancel()
+
+	db, err := ac.driver.Database(ctx, database)
+	if err != nil {
+		return 0, err
+	}
+
+	col, err := db.Collection(ctx, collection)
+	if err != nil {
+		return 0, err
+	}
+
+	return col.Count(ctx)
+}
@@ -21,6 +21,7 @@
 package backup
 
 import (
+	"fmt"
 	"math/rand"
 	"sync"
 	"time"
@@ -55,9 +56,10 @@ func newMockArangoClientBackupFactory(mock *mockArangoClientBackupState) ArangoC
 
 func newMockArangoClientBackup(errors mockErrorsArangoClientBackup) *mockArangoClientBackupState {
 	return &mockArangoClientBackupState{
-		backups:    map[driver.BackupID]driver.BackupMeta{},
-		progresses: map[driver.BackupTransferJobID]ArangoBackupProgress{},
-		errors:     errors,
+		backups:     map[driver.BackupID]driver.BackupMeta{},
+		progresses:  map[driver.BackupTransferJobID]ArangoBackupProgress{},
+		collections: map[string]int64{},
+		errors:      errors,
 	}
 }
 
@@ -68,8 +70,9 @@ type mockErrorsArangoClientBackup struct {
 type mockArangoClientBackupState struct {
 	lock sync.Mutex
 
-	backups    map[driver.BackupID]driver.BackupMeta
-	progresses map[driver.BackupTransferJobID]ArangoBackupProgress
+	backups     map[driver.BackupID]driver.BackupMeta
+	progresses  map[driver.BackupTransferJobID]ArangoBackupProgress
+	collections map[string]int64
 
 	errors mockErrorsArangoClientBackup
 }
@@ -227,6 +230,18 @@ func (m *mockArangoClientBackup) Create() (ArangoBackupCreateResponse, error) {
 	}, nil
 }
 
+func (m *mockArangoClientBackup) CollectionCount(database, collection string) (int64, error) {
+	m.state.lock.Lock()
+	defer m.state.lock.Unlock()
+
+	count, ok := m.state.collections[fmt.Sprintf("%s/%s", database, collection)]
+	if !ok {
+		return 0, errors.Newf("collection %s/%s not found", database, collection)
+	}
+
+	return count, nil
+}
+
 func (m *mockArangoClientBackup) getIDs() []string {
 	ret := make([]string, 0, len(m.state.backups))
 
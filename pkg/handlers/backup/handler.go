@@ -59,6 +59,9 @@ const (
 
 	// FinalizerChange name of the event send when finalizer removed entry
 	FinalizerChange = "FinalizerChange"
+
+	// BackupHookFailed name of the event send when backup hook failed
+	BackupHookFailed = "BackupHookFailed"
 )
 
 type handler struct {
@@ -74,6 +77,10 @@ type handler struct {
 	arangoClientTimeout time.Duration
 
 	operator operator.Operator
+
+	transfers backupTransfers
+
+	hookCalls backupHookCalls
 }
 
 func (h *handler) Start(stopCh <-chan struct{}) {
@@ -232,6 +239,8 @@ func (h *handler) Handle(item operation.Item) error {
 	b, err := h.client.BackupV1().ArangoBackups(item.Namespace).Get(context.Background(), item.Name, meta.GetOptions{})
 	if err != nil {
 		if apiErrors.IsNotFound(err) {
+			h.transfers.remove(item.Namespace, item.Name)
+			h.hookCalls.remove(item.Namespace, item.Name)
 			return nil
 		}
 
@@ -245,6 +254,9 @@ func (h *handler) Handle(item operation.Item) error {
 			item.Namespace,
 			item.Name)
 
+		h.transfers.remove(item.Namespace, item.Name)
+		h.hookCalls.remove(item.Namespace, item.Name)
+
 		return h.finalize(b)
 	}
 
@@ -311,6 +323,8 @@ func (h *handler) Handle(item operation.Item) error {
 		return nil
 	}
 
+	h.transfers.update(b, status)
+
 	// Nothing to update, objects are equal
 	if b.Status.Equal(status) {
 		return nil
@@ -367,7 +381,12 @@ func (h *handler) processArangoBackup(backup *backupApi.ArangoBackup) (*backupAp
 	}
 
 	if f, ok := stateHolders[backup.Status.State]; ok {
-		return f(h, backup)
+		status, err := f(h, backup)
+		if err != nil || status == nil {
+			return status, err
+		}
+
+		return h.processBackupVerification(backup, h.processBackupPostHooks(backup, status)), nil
 	}
 
 	return nil, errors.Newf("state %s is not supported", backup.Status.State)
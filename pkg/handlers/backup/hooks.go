@@ -0,0 +1,393 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/backup"
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+)
+
+type backupHookPhase string
+
+const (
+	backupHookPhasePre  backupHookPhase = "pre"
+	backupHookPhasePost backupHookPhase = "post"
+)
+
+const (
+	backupHookJobNameMaxLength  = 63
+	backupHookJobNameHashLength = 8
+)
+
+type backupHooksResult int
+
+const (
+	backupHooksInProgress backupHooksResult = iota
+	backupHooksSucceeded
+	backupHooksFailed
+)
+
+// backupHookRequest is send as a body of the HTTP hook
+type backupHookRequest struct {
+	Phase      backupHookPhase `json:"phase"`
+	Name       string          `json:"name"`
+	Namespace  string          `json:"namespace"`
+	Deployment string          `json:"deployment"`
+	State      string          `json:"state"`
+	BackupID   string          `json:"backupID,omitempty"`
+	Message    string          `json:"message,omitempty"`
+}
+
+// processBackupHooks executes hooks one after another. Hooks which are already finished are not executed again.
+// Returns statuses of the hooks, result and message describing the result.
+func (h *handler) processBackupHooks(backup *backupApi.ArangoBackup, status *backupApi.ArangoBackupStatus, phase backupHookPhase,
+	hooks []backupApi.ArangoBackupHook, statuses backupApi.ArangoBackupHookStatusList) (backupApi.ArangoBackupHookStatusList, backupHooksResult, string) {
+	result := make(backupApi.ArangoBackupHookStatusList, 0, len(hooks))
+
+	for id, hook := range hooks {
+		hookStatus, ok := statuses.Get(hook.Name)
+		if !ok || !hookStatus.State.IsFinal() {
+			if !ok {
+				now := meta.Now()
+				hookStatus = backupApi.ArangoBackupHookStatus{
+					Name:      hook.Name,
+					State:     backupApi.ArangoBackupHookStateRunning,
+					StartTime: &now,
+				}
+			}
+
+			hookStatus = h.runBackupHook(backup, status, phase, id, hook, hookStatus)
+
+			if hookStatus.State == backupApi.ArangoBackupHookStateFailed {
+				h.eventRecorder.Warning(backup, BackupHookFailed, "Backup %s hook %s failed: %s", phase, hook.Name, hookStatus.Message)
+			}
+		}
+
+		result = append(result, hookStatus)
+
+		switch hookStatus.State {
+		case backupApi.ArangoBackupHookStateRunning:
+			return result, backupHooksInProgress, fmt.Sprintf("waiting for %s hook %s", phase, hook.Name)
+		case backupApi.ArangoBackupHookStateFailed:
+			if hook.FailurePolicy.Get() == backupApi.ArangoBackupHookFailurePolicyFail {
+				return result, backupHooksFailed, fmt.Sprintf("%s hook %s failed: %s", phase, hook.Name, hookStatus.Message)
+			}
+		}
+	}
+
+	return result, backupHooksSucceeded, ""
+}
+
+// processBackupPostHooks executes post hooks once backup is Ready or Failed
+func (h *handler) processBackupPostHooks(backup *backupApi.ArangoBackup, status *backupApi.ArangoBackupStatus) *backupApi.ArangoBackupStatus {
+	hooks := backup.Spec.Hooks.GetPost()
+	if len(hooks) == 0 {
+		return status
+	}
+
+	if status.State != backupApi.ArangoBackupStateReady && status.State != backupApi.ArangoBackupStateFailed {
+		return status
+	}
+
+	statuses, _, _ := h.processBackupHooks(backup, status, backupHookPhasePost, hooks, status.Hooks.GetPost())
+
+	if status.Hooks == nil {
+		status.Hooks = &backupApi.ArangoBackupStatusHooks{}
+	}
+
+	status.Hooks.Post = statuses
+
+	return status
+}
+
+func (h *handler) runBackupHook(backup *backupApi.ArangoBackup, status *backupApi.ArangoBackupStatus, phase backupHookPhase, id int,
+	hook backupApi.ArangoBackupHook, hookStatus backupApi.ArangoBackupHookStatus) backupApi.ArangoBackupHookStatus {
+	if hook.HTTP != nil {
+		return h.runBackupHookHTTP(backup, status, phase, hook, hookStatus)
+	}
+
+	return h.runBackupHookJob(backup, phase, id, hook, hookStatus)
+}
+
+func (h *handler) runBackupHookHTTP(backup *backupApi.ArangoBackup, status *backupApi.ArangoBackupStatus, phase backupHookPhase,
+	hook backupApi.ArangoBackupHook, hookStatus backupApi.ArangoBackupHookStatus) backupApi.ArangoBackupHookStatus {
+	call := fmt.Sprintf("%s/%s", phase, hook.Name)
+
+	if started, done, err := h.hookCalls.poll(backup.GetNamespace(), backup.GetName(), call); started {
+		if !done {
+			return hookStatus
+		}
+
+		return finishBackupHook(hookStatus, err)
+	}
+
+	// Request is not in progress, it was never started or operator was restarted in the meantime
+	if s := hookStatus.StartTime; s != nil && time.Since(s.Time) > hook.GetTimeout() {
+		return finishBackupHook(hookStatus, errors.Newf("timeout of %s exceeded", hook.GetTimeout().String()))
+	}
+
+	request := backupHookRequest{
+		Phase:      phase,
+		Name:       backup.GetName(),
+		Namespace:  backup.GetNamespace(),
+		Deployment: backup.Spec.Deployment.Name,
+		State:      string(status.State),
+		Message:    status.Message,
+	}
+
+	if status.Backup != nil {
+		request.BackupID = status.Backup.ID
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return finishBackupHook(hookStatus, err)
+	}
+
+	namespace, name := backup.GetNamespace(), backup.GetName()
+	h.hookCalls.start(namespace, name, call, func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), hook.GetTimeout())
+		defer cancel()
+
+		return callBackupHookHTTP(ctx, hook.HTTP, body)
+	}, func() {
+		h.enqueueBackup(namespace, name)
+	})
+
+	return hookStatus
+}
+
+func callBackupHookHTTP(ctx context.Context, hook *backupApi.ArangoBackupHookHTTP, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, hook.GetMethod(), hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range hook.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Newf("unexpected response code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// enqueueBackup triggers the next handler iteration for the backup
+func (h *handler) enqueueBackup(namespace, name string) {
+	if h.operator == nil {
+		return
+	}
+
+	item, err := operation.NewItem(operation.Update, backupApi.SchemeGroupVersion.Group,
+		backupApi.SchemeGroupVersion.Version, backup.ArangoBackupResourceKind, namespace, name)
+	if err != nil {
+		return
+	}
+
+	h.operator.EnqueueItem(item)
+}
+
+func (h *handler) runBackupHookJob(backup *backupApi.ArangoBackup, phase backupHookPhase, id int,
+	hook backupApi.ArangoBackupHook, hookStatus backupApi.ArangoBackupHookStatus) backupApi.ArangoBackupHookStatus {
+	name := backupHookJobName(backup.GetName(), phase, id)
+	jobs := h.kubeClient.BatchV1().Jobs(backup.GetNamespace())
+
+	ctx, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(context.Background())
+	defer cancel()
+
+	job, err := jobs.Get(ctx, name, meta.GetOptions{})
+	if err != nil {
+		if !apiErrors.IsNotFound(err) {
+			// Retry in next iteration
+			hookStatus.Message = err.Error()
+			return hookStatus
+		}
+
+		job = &batch.Job{
+			ObjectMeta: meta.ObjectMeta{
+				Name:      name,
+				Namespace: backup.GetNamespace(),
+				OwnerReferences: []meta.OwnerReference{
+					backup.AsOwner(),
+				},
+			},
+			Spec: *hook.JobTemplate.DeepCopy(),
+		}
+
+		if job.Spec.ActiveDeadlineSeconds == nil {
+			deadline := int64(hook.GetTimeout() / time.Second)
+			job.Spec.ActiveDeadlineSeconds = &deadline
+		}
+
+		if _, err := jobs.Create(ctx, job, meta.CreateOptions{}); err != nil {
+			return finishBackupHook(hookStatus, errors.Newf("unable to create job: %s", err.Error()))
+		}
+
+		hookStatus.Message = ""
+		return hookStatus
+	}
+
+	for _, c := range job.Status.Conditions {
+		if c.Status != core.ConditionTrue {
+			continue
+		}
+
+		switch c.Type {
+		case batch.JobComplete:
+			return finishBackupHook(hookStatus, nil)
+		case batch.JobFailed:
+			return finishBackupHook(hookStatus, errors.Newf("job failed: %s", c.Message))
+		}
+	}
+
+	if s := hookStatus.StartTime; s != nil && time.Since(s.Time) > hook.GetTimeout() {
+		return finishBackupHook(hookStatus, errors.Newf("timeout of %s exceeded", hook.GetTimeout().String()))
+	}
+
+	return hookStatus
+}
+
+// backupHookJobName returns name of the hook Job. Names longer than the limit are truncated and suffixed with a hash
+func backupHookJobName(backupName string, phase backupHookPhase, id int) string {
+	name := fmt.Sprintf("%s-%s-hook-%d", backupName, phase, id)
+	if len(name) <= backupHookJobNameMaxLength {
+		return name
+	}
+
+	hash := util.SHA256FromString(name)[:backupHookJobNameHashLength]
+
+	return fmt.Sprintf("%s-%s", strings.TrimRight(name[:backupHookJobNameMaxLength-backupHookJobNameHashLength-1], "-."), hash)
+}
+
+func finishBackupHook(hookStatus backupApi.ArangoBackupHookStatus, err error) backupApi.ArangoBackupHookStatus {
+	now := meta.Now()
+	hookStatus.CompletionTime = &now
+
+	if err != nil {
+		hookStatus.State = backupApi.ArangoBackupHookStateFailed
+		hookStatus.Message = err.Error()
+	} else {
+		hookStatus.State = backupApi.ArangoBackupHookStateSucceeded
+		hookStatus.Message = ""
+	}
+
+	return hookStatus
+}
+
+type backupHookCall struct {
+	done bool
+	err  error
+}
+
+// backupHookCalls keeps HTTP hook requests which are executed in the background, per backup
+type backupHookCalls struct {
+	lock sync.Mutex
+
+	calls map[string]map[string]*backupHookCall
+}
+
+// start executes the call in the background. Finished func is invoked once the result is available
+func (b *backupHookCalls) start(namespace, name, call string, f func() error, finished func()) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.calls == nil {
+		b.calls = map[string]map[string]*backupHookCall{}
+	}
+
+	key := fmt.Sprintf("%s/%s", namespace, name)
+	if b.calls[key] == nil {
+		b.calls[key] = map[string]*backupHookCall{}
+	}
+
+	c := &backupHookCall{}
+	b.calls[key][call] = c
+
+	go func() {
+		err := f()
+
+		b.lock.Lock()
+		c.done = true
+		c.err = err
+		b.lock.Unlock()
+
+		finished()
+	}()
+}
+
+// poll returns state of the call. Finished call is forgotten once its result is returned.
+func (b *backupHookCalls) poll(namespace, name, call string) (started, done bool, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	key := fmt.Sprintf("%s/%s", namespace, name)
+
+	c, ok := b.calls[key][call]
+	if !ok {
+		return false, false, nil
+	}
+
+	if !c.done {
+		return true, false, nil
+	}
+
+	delete(b.calls[key], call)
+	if len(b.calls[key]) == 0 {
+		delete(b.calls, key)
+	}
+
+	return true, true, c.err
+}
+
+// remove forgets all calls of the backup. Calls which are still running are not interrupted.
+func (b *backupHookCalls) remove(namespace, name string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delete(b.calls, fmt.Sprintf("%s/%s", namespace, name))
+}
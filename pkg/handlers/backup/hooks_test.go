@@ -0,0 +1,405 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func newHookServer(t *testing.T, code int) (*httptest.Server, chan backupHookRequest) {
+	requests := make(chan backupHookRequest, 16)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req backupHookRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests <- req
+
+		w.WriteHeader(code)
+	}))
+
+	return server, requests
+}
+
+func newHTTPHook(name, url string, policy backupApi.ArangoBackupHookFailurePolicy) backupApi.ArangoBackupHook {
+	return backupApi.ArangoBackupHook{
+		Name: name,
+		HTTP: &backupApi.ArangoBackupHookHTTP{
+			URL: url,
+		},
+		FailurePolicy: &policy,
+	}
+}
+
+// newTestServerHTTPHook returns hook pointing to the test server, which is not a Kubernetes Service
+func newTestServerHTTPHook(name, url string, policy backupApi.ArangoBackupHookFailurePolicy) backupApi.ArangoBackupHook {
+	hook := newHTTPHook(name, url, policy)
+	hook.HTTP.AllowExternal = util.NewBool(true)
+	return hook
+}
+
+// handleUntilHooksFinished executes handler until HTTP hooks executed in the background are finished
+func handleUntilHooksFinished(t *testing.T, handler *handler, obj *backupApi.ArangoBackup) *backupApi.ArangoBackup {
+	var newObj *backupApi.ArangoBackup
+
+	require.Eventually(t, func() bool {
+		require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+		newObj = refreshArangoBackup(t, handler, obj)
+
+		for _, hooks := range []backupApi.ArangoBackupHookStatusList{newObj.Status.Hooks.GetPre(), newObj.Status.Hooks.GetPost()} {
+			for _, hook := range hooks {
+				if !hook.State.IsFinal() {
+					return false
+				}
+			}
+		}
+
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return newObj
+}
+
+func Test_Hooks_Pre_HTTP(t *testing.T) {
+	t.Run("Succeeded", func(t *testing.T) {
+		// Arrange
+		handler, _ := newErrorsFakeHandler(mockErrorsArangoClientBackup{})
+		server, requests := newHookServer(t, http.StatusOK)
+		defer server.Close()
+
+		obj, deployment := newObjectSet(backupApi.ArangoBackupStateScheduled)
+		obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+			Pre: []backupApi.ArangoBackupHook{
+				newTestServerHTTPHook("quiesce", server.URL, backupApi.ArangoBackupHookFailurePolicyFail),
+			},
+		}
+
+		// Act
+		createArangoDeployment(t, handler, deployment)
+		createArangoBackup(t, handler, obj)
+
+		newObj := handleUntilHooksFinished(t, handler, obj)
+
+		// Assert
+		checkBackup(t, newObj, backupApi.ArangoBackupStateCreate, false)
+
+		require.NotNil(t, newObj.Status.Hooks)
+		require.Len(t, newObj.Status.Hooks.Pre, 1)
+		require.Equal(t, backupApi.ArangoBackupHookStateSucceeded, newObj.Status.Hooks.Pre[0].State)
+
+		req := <-requests
+		require.Equal(t, backupHookPhasePre, req.Phase)
+		require.Equal(t, obj.Name, req.Name)
+		require.Equal(t, obj.Spec.Deployment.Name, req.Deployment)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		// Arrange
+		handler, _ := newErrorsFakeHandler(mockErrorsArangoClientBackup{})
+		server, _ := newHookServer(t, http.StatusInternalServerError)
+		defer server.Close()
+
+		obj, deployment := newObjectSet(backupApi.ArangoBackupStateScheduled)
+		obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+			Pre: []backupApi.ArangoBackupHook{
+				newTestServerHTTPHook("quiesce", server.URL, backupApi.ArangoBackupHookFailurePolicyFail),
+			},
+		}
+
+		// Act
+		createArangoDeployment(t, handler, deployment)
+		createArangoBackup(t, handler, obj)
+
+		newObj := handleUntilHooksFinished(t, handler, obj)
+
+		// Assert
+		checkBackup(t, newObj, backupApi.ArangoBackupStateFailed, false)
+
+		require.NotNil(t, newObj.Status.Hooks)
+		require.Len(t, newObj.Status.Hooks.Pre, 1)
+		require.Equal(t, backupApi.ArangoBackupHookStateFailed, newObj.Status.Hooks.Pre[0].State)
+		require.Equal(t, "unexpected response code 500", newObj.Status.Hooks.Pre[0].Message)
+	})
+
+	t.Run("Failed with Ignore policy", func(t *testing.T) {
+		// Arrange
+		handler, _ := newErrorsFakeHandler(mockErrorsArangoClientBackup{})
+		server, _ := newHookServer(t, http.StatusInternalServerError)
+		defer server.Close()
+
+		obj, deployment := newObjectSet(backupApi.ArangoBackupStateScheduled)
+		obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+			Pre: []backupApi.ArangoBackupHook{
+				newTestServerHTTPHook("quiesce", server.URL, backupApi.ArangoBackupHookFailurePolicyIgnore),
+			},
+		}
+
+		// Act
+		createArangoDeployment(t, handler, deployment)
+		createArangoBackup(t, handler, obj)
+
+		newObj := handleUntilHooksFinished(t, handler, obj)
+
+		// Assert
+		checkBackup(t, newObj, backupApi.ArangoBackupStateCreate, false)
+
+		require.Equal(t, backupApi.ArangoBackupHookStateFailed, newObj.Status.Hooks.Pre[0].State)
+	})
+}
+
+func Test_Hooks_Pre_HTTP_Async(t *testing.T) {
+	// Arrange
+	handler, _ := newErrorsFakeHandler(mockErrorsArangoClientBackup{})
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateScheduled)
+	obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+		Pre: []backupApi.ArangoBackupHook{
+			newTestServerHTTPHook("quiesce", server.URL, backupApi.ArangoBackupHookFailurePolicyFail),
+		},
+	}
+
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+
+	t.Run("Handler is not blocked by the request", func(t *testing.T) {
+		// Act
+		require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+		require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+		// Assert
+		newObj := refreshArangoBackup(t, handler, obj)
+		checkBackup(t, newObj, backupApi.ArangoBackupStateScheduled, false)
+		require.Equal(t, backupApi.ArangoBackupHookStateRunning, newObj.Status.Hooks.Pre[0].State)
+	})
+
+	t.Run("Request is finished", func(t *testing.T) {
+		// Act
+		close(release)
+		newObj := handleUntilHooksFinished(t, handler, obj)
+
+		// Assert
+		checkBackup(t, newObj, backupApi.ArangoBackupStateCreate, false)
+		require.Equal(t, backupApi.ArangoBackupHookStateSucceeded, newObj.Status.Hooks.Pre[0].State)
+	})
+}
+
+func Test_Hooks_Job_Name(t *testing.T) {
+	require.Equal(t, "backup-pre-hook-0", backupHookJobName("backup", backupHookPhasePre, 0))
+
+	long := strings.Repeat("a", 60)
+	name := backupHookJobName(long, backupHookPhasePost, 1)
+	require.Len(t, name, 63)
+	require.True(t, strings.HasPrefix(name, long[:54]))
+	require.NotEqual(t, name, backupHookJobName(long, backupHookPhasePost, 2))
+}
+
+func Test_Hooks_Pre_Job(t *testing.T) {
+	// Arrange
+	handler, _ := newErrorsFakeHandler(mockErrorsArangoClientBackup{})
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateScheduled)
+	obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+		Pre: []backupApi.ArangoBackupHook{
+			{
+				Name:        "quiesce",
+				JobTemplate: &batch.JobSpec{},
+			},
+		},
+	}
+
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+
+	t.Run("Job is created", func(t *testing.T) {
+		// Act
+		require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+		// Assert
+		newObj := refreshArangoBackup(t, handler, obj)
+		checkBackup(t, newObj, backupApi.ArangoBackupStateScheduled, false)
+		require.Equal(t, backupApi.ArangoBackupHookStateRunning, newObj.Status.Hooks.Pre[0].State)
+
+		job, err := handler.kubeClient.BatchV1().Jobs(obj.Namespace).Get(context.Background(), obj.Name+"-pre-hook-0", meta.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, job.Spec.ActiveDeadlineSeconds)
+		require.EqualValues(t, 60, *job.Spec.ActiveDeadlineSeconds)
+		require.Len(t, job.OwnerReferences, 1)
+		require.Equal(t, obj.UID, job.OwnerReferences[0].UID)
+	})
+
+	t.Run("Job is completed", func(t *testing.T) {
+		// Arrange
+		job, err := handler.kubeClient.BatchV1().Jobs(obj.Namespace).Get(context.Background(), obj.Name+"-pre-hook-0", meta.GetOptions{})
+		require.NoError(t, err)
+
+		job.Status.Conditions = append(job.Status.Conditions, batch.JobCondition{
+			Type:   batch.JobComplete,
+			Status: core.ConditionTrue,
+		})
+
+		_, err = handler.kubeClient.BatchV1().Jobs(obj.Namespace).UpdateStatus(context.Background(), job, meta.UpdateOptions{})
+		require.NoError(t, err)
+
+		// Act
+		require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+		// Assert
+		newObj := refreshArangoBackup(t, handler, obj)
+		checkBackup(t, newObj, backupApi.ArangoBackupStateCreate, false)
+		require.Equal(t, backupApi.ArangoBackupHookStateSucceeded, newObj.Status.Hooks.Pre[0].State)
+	})
+}
+
+func Test_Hooks_Post_HTTP(t *testing.T) {
+	// Arrange
+	handler, mock := newErrorsFakeHandler(mockErrorsArangoClientBackup{})
+	server, requests := newHookServer(t, http.StatusOK)
+	defer server.Close()
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateCreate)
+	obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+		Post: []backupApi.ArangoBackupHook{
+			newTestServerHTTPHook("notify", server.URL, backupApi.ArangoBackupHookFailurePolicyFail),
+		},
+	}
+
+	// Act
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+	newObj := handleUntilHooksFinished(t, handler, obj)
+
+	// Assert
+	checkBackup(t, newObj, backupApi.ArangoBackupStateReady, true)
+
+	require.NotNil(t, newObj.Status.Hooks)
+	require.Len(t, newObj.Status.Hooks.Post, 1)
+	require.Equal(t, backupApi.ArangoBackupHookStateSucceeded, newObj.Status.Hooks.Post[0].State)
+
+	backups, err := mock.List()
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+
+	req := <-requests
+	require.Equal(t, backupHookPhasePost, req.Phase)
+	require.Equal(t, string(backupApi.ArangoBackupStateReady), req.State)
+	require.Equal(t, newObj.Status.Backup.ID, req.BackupID)
+
+	t.Run("Hook is not executed again", func(t *testing.T) {
+		// Act
+		require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+		// Assert
+		require.Len(t, requests, 0)
+	})
+}
+
+func Test_Hooks_Validate(t *testing.T) {
+	t.Run("Missing action", func(t *testing.T) {
+		hooks := &backupApi.ArangoBackupSpecHooks{
+			Pre: []backupApi.ArangoBackupHook{{Name: "a"}},
+		}
+
+		require.EqualError(t, hooks.Validate(), "invalid pre hooks: hook a needs to define exactly one of jobTemplate or http")
+	})
+
+	t.Run("Duplicated name", func(t *testing.T) {
+		hooks := &backupApi.ArangoBackupSpecHooks{
+			Post: []backupApi.ArangoBackupHook{
+				newHTTPHook("a", "http://svc:8080/", backupApi.ArangoBackupHookFailurePolicyFail),
+				newHTTPHook("a", "http://svc:8080/", backupApi.ArangoBackupHookFailurePolicyFail),
+			},
+		}
+
+		require.EqualError(t, hooks.Validate(), "invalid post hooks: hook a is defined more than once")
+	})
+
+	t.Run("Invalid failure policy", func(t *testing.T) {
+		hooks := &backupApi.ArangoBackupSpecHooks{
+			Pre: []backupApi.ArangoBackupHook{
+				newHTTPHook("a", "http://svc:8080/", "Unknown"),
+			},
+		}
+
+		require.EqualError(t, hooks.Validate(), "invalid pre hooks: unknown failurePolicy: Unknown")
+	})
+
+	t.Run("External URL", func(t *testing.T) {
+		for _, url := range []string{"http://example.com/", "http://10.0.0.1:8080/", "http://svc.namespace:8080/"} {
+			hooks := &backupApi.ArangoBackupSpecHooks{
+				Pre: []backupApi.ArangoBackupHook{
+					newHTTPHook("a", url, backupApi.ArangoBackupHookFailurePolicyFail),
+				},
+			}
+
+			require.Error(t, hooks.Validate(), url)
+
+			hooks.Pre[0].HTTP.AllowExternal = util.NewBool(true)
+			require.NoError(t, hooks.Validate(), url)
+		}
+	})
+
+	t.Run("Service URL", func(t *testing.T) {
+		for _, url := range []string{"http://svc:8080/", "http://svc.namespace.svc:8080/", "https://svc.namespace.svc.cluster.local/hook"} {
+			hooks := &backupApi.ArangoBackupSpecHooks{
+				Pre: []backupApi.ArangoBackupHook{
+					newHTTPHook("a", url, backupApi.ArangoBackupHookFailurePolicyFail),
+				},
+			}
+
+			require.NoError(t, hooks.Validate(), url)
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		hooks := &backupApi.ArangoBackupSpecHooks{
+			Pre: []backupApi.ArangoBackupHook{
+				newHTTPHook("a", "http://svc:8080/", backupApi.ArangoBackupHookFailurePolicyFail),
+				{Name: "b", JobTemplate: &batch.JobSpec{}},
+			},
+		}
+
+		require.NoError(t, hooks.Validate())
+	})
+}
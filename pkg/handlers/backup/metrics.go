@@ -0,0 +1,135 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/generated/metric_descriptions"
+	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
+)
+
+const (
+	backupTransferOperationUpload   = "upload"
+	backupTransferOperationDownload = "download"
+)
+
+type backupTransfer struct {
+	namespace, name, operation string
+
+	progress *backupApi.ArangoBackupProgress
+}
+
+type backupTransfers struct {
+	lock sync.Mutex
+
+	transfers map[string]backupTransfer
+}
+
+// update saves transfer progress of the backup. Transfer is removed once backup leaves Uploading or Downloading state
+func (b *backupTransfers) update(backup *backupApi.ArangoBackup, status *backupApi.ArangoBackupStatus) {
+	var operation string
+
+	switch status.State {
+	case backupApi.ArangoBackupStateUploading:
+		operation = backupTransferOperationUpload
+	case backupApi.ArangoBackupStateDownloading:
+		operation = backupTransferOperationDownload
+	}
+
+	if operation == "" || status.Progress == nil {
+		b.remove(backup.Namespace, backup.Name)
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.transfers == nil {
+		b.transfers = map[string]backupTransfer{}
+	}
+
+	b.transfers[fmt.Sprintf("%s/%s", backup.Namespace, backup.Name)] = backupTransfer{
+		namespace: backup.Namespace,
+		name:      backup.Name,
+		operation: operation,
+		progress:  status.Progress.DeepCopy(),
+	}
+}
+
+func (b *backupTransfers) remove(namespace, name string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delete(b.transfers, fmt.Sprintf("%s/%s", namespace, name))
+}
+
+func (b *backupTransfers) CollectMetrics(m metrics.PushMetric) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := time.Now()
+
+	for _, t := range b.transfers {
+		p := t.progress
+
+		m.Push(metric_descriptions.ArangodbOperatorBackupTransferBytesDoneGauge(float64(p.BytesDone), t.namespace, t.name, t.operation))
+		m.Push(metric_descriptions.ArangodbOperatorBackupTransferBytesTotalGauge(float64(p.BytesTotal), t.namespace, t.name, t.operation))
+		m.Push(metric_descriptions.ArangodbOperatorBackupTransferBytesPerSecondGauge(float64(p.BytesPerSecond), t.namespace, t.name, t.operation))
+
+		if eta := p.EstimatedCompletionTime; eta != nil {
+			m.Push(metric_descriptions.ArangodbOperatorBackupTransferEstimatedCompletionSecondsGauge(remainingSeconds(eta.Time, now), t.namespace, t.name, t.operation))
+		}
+
+		for _, server := range p.DBServers {
+			m.Push(metric_descriptions.ArangodbOperatorBackupTransferDbserverFilesDoneGauge(float64(server.FilesDone), t.namespace, t.name, t.operation, server.ID))
+			m.Push(metric_descriptions.ArangodbOperatorBackupTransferDbserverFilesTotalGauge(float64(server.FilesTotal), t.namespace, t.name, t.operation, server.ID))
+
+			if last := server.LastUpdateTime; last != nil {
+				m.Push(metric_descriptions.ArangodbOperatorBackupTransferDbserverLastUpdateGauge(float64(last.Unix()), t.namespace, t.name, t.operation, server.ID))
+			}
+
+			if eta := server.EstimatedCompletionTime; eta != nil {
+				m.Push(metric_descriptions.ArangodbOperatorBackupTransferDbserverEstimatedCompletionSecondsGauge(remainingSeconds(eta.Time, now), t.namespace, t.name, t.operation, server.ID))
+			}
+		}
+	}
+}
+
+func remainingSeconds(eta, now time.Time) float64 {
+	if r := eta.Sub(now).Seconds(); r > 0 {
+		return r
+	}
+
+	return 0
+}
+
+func (h *handler) Describe(descs chan<- *prometheus.Desc) {
+}
+
+func (h *handler) Collect(c chan<- prometheus.Metric) {
+	h.transfers.CollectMetrics(metrics.NewPushMetric(c))
+}
@@ -0,0 +1,127 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+)
+
+// newBackupProgress builds transfer progress details. Throughput and estimations are recalculated only when
+// the number of transferred files changes, so status is not updated while transfer is not moving.
+func newBackupProgress(old *backupApi.ArangoBackupProgress, jobID string, details ArangoBackupProgress, size uint64, now time.Time) *backupApi.ArangoBackupProgress {
+	if old != nil && old.JobID != jobID {
+		old = nil
+	}
+
+	progress := &backupApi.ArangoBackupProgress{
+		JobID:    jobID,
+		Progress: fmt.Sprintf("%d%%", details.Progress),
+	}
+
+	start := meta.NewTime(now)
+	if old != nil && old.StartTime != nil {
+		start = *old.StartTime
+	}
+	progress.StartTime = &start
+
+	ids := make([]string, 0, len(details.DBServers))
+	for id := range details.DBServers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var done, total, oldDone int
+
+	if old != nil {
+		for _, server := range old.DBServers {
+			oldDone += server.FilesDone
+		}
+	}
+
+	for _, id := range ids {
+		server := details.DBServers[id]
+
+		done += server.Done
+		total += server.Total
+
+		p := backupApi.ArangoBackupDBServerProgress{
+			ID:         id,
+			Status:     string(server.Status),
+			Message:    server.Message,
+			FilesDone:  server.Done,
+			FilesTotal: server.Total,
+		}
+
+		if o, ok := old.GetDBServers().Get(id); ok && o.FilesDone == server.Done {
+			p.LastUpdateTime = o.LastUpdateTime
+			p.EstimatedCompletionTime = o.EstimatedCompletionTime
+		} else {
+			p.LastUpdateTime = &meta.Time{Time: now}
+			p.EstimatedCompletionTime = estimateCompletionTime(start.Time, now, server.Done, server.Total)
+		}
+
+		progress.DBServers = append(progress.DBServers, p)
+	}
+
+	if old != nil && oldDone == done {
+		progress.BytesDone = old.BytesDone
+		progress.BytesTotal = old.BytesTotal
+		progress.BytesPerSecond = old.BytesPerSecond
+		progress.EstimatedCompletionTime = old.EstimatedCompletionTime
+
+		return progress
+	}
+
+	if total > 0 {
+		progress.BytesTotal = size
+		progress.BytesDone = uint64(float64(size) * float64(done) / float64(total))
+	}
+
+	if elapsed := now.Sub(start.Time).Seconds(); elapsed > 0 {
+		progress.BytesPerSecond = uint64(float64(progress.BytesDone) / elapsed)
+	}
+
+	progress.EstimatedCompletionTime = estimateCompletionTime(start.Time, now, done, total)
+
+	return progress
+}
+
+// estimateCompletionTime assumes that remaining files are transferred with the average speed observed since start
+func estimateCompletionTime(start, now time.Time, done, total int) *meta.Time {
+	if done <= 0 || total <= done {
+		return nil
+	}
+
+	elapsed := now.Sub(start)
+	if elapsed <= 0 {
+		return nil
+	}
+
+	remaining := time.Duration(float64(elapsed) * float64(total-done) / float64(done))
+
+	return &meta.Time{Time: now.Add(remaining)}
+}
@@ -0,0 +1,92 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver"
+)
+
+func Test_BackupProgress(t *testing.T) {
+	start := time.Now()
+
+	details := func(done1, done2 int) ArangoBackupProgress {
+		return ArangoBackupProgress{
+			Progress: (100 * (done1 + done2)) / 40,
+			DBServers: map[string]ArangoBackupTransferProgress{
+				"PRMR-2": {Status: driver.TransferStarted, Done: done2, Total: 20},
+				"PRMR-1": {Status: driver.TransferStarted, Done: done1, Total: 20},
+			},
+		}
+	}
+
+	// Arrange
+	p := newBackupProgress(nil, "job", details(0, 0), 4000, start)
+
+	// Assert
+	require.Equal(t, "job", p.JobID)
+	require.Equal(t, "0%", p.Progress)
+	require.NotNil(t, p.StartTime)
+	require.Len(t, p.DBServers, 2)
+	require.Equal(t, "PRMR-1", p.DBServers[0].ID)
+	require.Equal(t, "PRMR-2", p.DBServers[1].ID)
+	require.Nil(t, p.EstimatedCompletionTime)
+
+	t.Run("Progress moved", func(t *testing.T) {
+		// Act
+		p = newBackupProgress(p, "job", details(10, 0), 4000, start.Add(10*time.Second))
+
+		// Assert
+		require.Equal(t, "25%", p.Progress)
+		require.EqualValues(t, 1000, p.BytesDone)
+		require.EqualValues(t, 4000, p.BytesTotal)
+		require.EqualValues(t, 100, p.BytesPerSecond)
+		require.NotNil(t, p.EstimatedCompletionTime)
+		require.Equal(t, start.Add(40*time.Second).Unix(), p.EstimatedCompletionTime.Unix())
+
+		require.Equal(t, start.Add(10*time.Second).Unix(), p.DBServers[0].LastUpdateTime.Unix())
+		require.Equal(t, start.Add(20*time.Second).Unix(), p.DBServers[0].EstimatedCompletionTime.Unix())
+
+		require.Equal(t, start.Unix(), p.DBServers[1].LastUpdateTime.Unix())
+		require.Nil(t, p.DBServers[1].EstimatedCompletionTime)
+	})
+
+	t.Run("Progress stuck", func(t *testing.T) {
+		// Act
+		n := newBackupProgress(p, "job", details(10, 0), 4000, start.Add(60*time.Second))
+
+		// Assert
+		require.True(t, n.Equal(p))
+	})
+
+	t.Run("New job", func(t *testing.T) {
+		// Act
+		n := newBackupProgress(p, "job2", details(0, 0), 4000, start.Add(60*time.Second))
+
+		// Assert
+		require.Equal(t, start.Add(60*time.Second).Unix(), n.StartTime.Unix())
+		require.EqualValues(t, 0, n.BytesDone)
+	})
+}
@@ -41,5 +41,6 @@ func statePendingHandler(h *handler, backup *backupApi.ArangoBackup) (*backupApi
 	}
 
 	return wrapUpdateStatus(backup,
-		updateStatusState(backupApi.ArangoBackupStateScheduled, ""))
+		updateStatusState(backupApi.ArangoBackupStateScheduled, ""),
+		cleanStatusHooks())
 }
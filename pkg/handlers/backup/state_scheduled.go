@@ -36,6 +36,26 @@ func stateScheduledHandler(h *handler, backup *backupApi.ArangoBackup) (*backupA
 			updateStatusState(backupApi.ArangoBackupStateDownload, ""))
 	}
 
+	if hooks := backup.Spec.Hooks.GetPre(); len(hooks) > 0 {
+		statuses, result, message := h.processBackupHooks(backup, &backup.Status, backupHookPhasePre, hooks, backup.Status.Hooks.GetPre())
+
+		switch result {
+		case backupHooksInProgress:
+			return wrapUpdateStatus(backup,
+				updateStatusState(backupApi.ArangoBackupStateScheduled, message),
+				updateStatusPreHooks(statuses))
+		case backupHooksFailed:
+			return wrapUpdateStatus(backup,
+				updateStatusState(backupApi.ArangoBackupStateFailed, message),
+				updateStatusPreHooks(statuses),
+				updateStatusAvailable(false))
+		}
+
+		return wrapUpdateStatus(backup,
+			updateStatusState(backupApi.ArangoBackupStateCreate, ""),
+			updateStatusPreHooks(statuses))
+	}
+
 	return wrapUpdateStatus(backup,
 		updateStatusState(backupApi.ArangoBackupStateCreate, ""))
 }
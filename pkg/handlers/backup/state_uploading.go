@@ -21,8 +21,6 @@
 package backup
 
 import (
-	"fmt"
-
 	"github.com/arangodb/go-driver"
 
 	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
@@ -98,6 +96,6 @@ func stateUploadingHandler(h *handler, backup *backupApi.ArangoBackup) (*backupA
 	return wrapUpdateStatus(backup,
 		updateStatusState(backupApi.ArangoBackupStateUploading, ""),
 		updateStatusAvailable(true),
-		updateStatusJob(backup.Status.Progress.JobID, fmt.Sprintf("%d%%", details.Progress)),
+		updateStatusJobProgress(backup.Status.Progress.JobID, details),
 	)
 }
@@ -81,6 +81,9 @@ func Test_State_Uploading_Success(t *testing.T) {
 		p := 55
 		mock.state.progresses[progress] = ArangoBackupProgress{
 			Progress: p,
+			DBServers: map[string]ArangoBackupTransferProgress{
+				"PRMR-1": {Status: driver.TransferStarted, Done: 11, Total: 20},
+			},
 		}
 
 		require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
@@ -90,6 +93,17 @@ func Test_State_Uploading_Success(t *testing.T) {
 		checkBackup(t, newObj, backupApi.ArangoBackupStateUploading, true)
 		require.Equal(t, fmt.Sprintf("%d%%", p), newObj.Status.Progress.Progress)
 		require.Equal(t, string(progress), newObj.Status.Progress.JobID)
+		require.NotNil(t, newObj.Status.Progress.StartTime)
+
+		require.Len(t, newObj.Status.Progress.DBServers, 1)
+		server := newObj.Status.Progress.DBServers[0]
+		require.Equal(t, "PRMR-1", server.ID)
+		require.Equal(t, string(driver.TransferStarted), server.Status)
+		require.Equal(t, 11, server.FilesDone)
+		require.Equal(t, 20, server.FilesTotal)
+		require.NotNil(t, server.LastUpdateTime)
+
+		require.Contains(t, handler.transfers.transfers, fmt.Sprintf("%s/%s", obj.Namespace, obj.Name))
 	})
 
 	t.Run("Finished", func(t *testing.T) {
@@ -106,6 +120,8 @@ func Test_State_Uploading_Success(t *testing.T) {
 
 		require.NotNil(t, newObj.Status.Backup.Uploaded)
 		require.True(t, *newObj.Status.Backup.Uploaded)
+
+		require.NotContains(t, handler.transfers.transfers, fmt.Sprintf("%s/%s", obj.Namespace, obj.Name))
 	})
 }
 
@@ -23,6 +23,7 @@ package backup
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -87,6 +88,17 @@ func updateStatusJob(id, progress string) updateStatusFunc {
 	}
 }
 
+func updateStatusJobProgress(id string, details ArangoBackupProgress) updateStatusFunc {
+	return func(status *backupApi.ArangoBackupStatus) {
+		var size uint64
+		if status.Backup != nil {
+			size = status.Backup.SizeInBytes
+		}
+
+		status.Progress = newBackupProgress(status.Progress, id, details, size, time.Now())
+	}
+}
+
 func updateStatusBackupUpload(uploaded *bool) updateStatusFunc {
 	return func(status *backupApi.ArangoBackupStatus) {
 		if status.Backup != nil {
@@ -117,6 +129,22 @@ func updateStatusBackup(backupMeta driver.BackupMeta) updateStatusFunc {
 	}
 }
 
+func updateStatusPreHooks(statuses backupApi.ArangoBackupHookStatusList) updateStatusFunc {
+	return func(status *backupApi.ArangoBackupStatus) {
+		if status.Hooks == nil {
+			status.Hooks = &backupApi.ArangoBackupStatusHooks{}
+		}
+
+		status.Hooks.Pre = statuses
+	}
+}
+
+func cleanStatusHooks() updateStatusFunc {
+	return func(status *backupApi.ArangoBackupStatus) {
+		status.Hooks = nil
+	}
+}
+
 func cleanStatusJob() updateStatusFunc {
 	return func(status *backupApi.ArangoBackupStatus) {
 		status.Progress = nil
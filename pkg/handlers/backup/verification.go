@@ -0,0 +1,440 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appsApi "github.com/arangodb/kube-arangodb/pkg/apis/apps/v1"
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	database "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/globals"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+const (
+	// BackupVerification name of the event send when backup verification is finished
+	BackupVerification = "BackupVerification"
+)
+
+// processBackupVerification restores uploaded backup into the scratch deployment, runs checks and records result
+// in the Verified condition. Verification is executed only once for the backup.
+func (h *handler) processBackupVerification(backup *backupApi.ArangoBackup, status *backupApi.ArangoBackupStatus) *backupApi.ArangoBackupStatus {
+	spec := backup.Spec.Verification
+	if spec == nil {
+		return status
+	}
+
+	if status.State != backupApi.ArangoBackupStateReady || status.Backup == nil || !util.BoolOrDefault(status.Backup.Uploaded) {
+		return status
+	}
+
+	if status.Verification.GetPhase() == backupApi.ArangoBackupVerificationPhaseCompleted {
+		return status
+	}
+
+	if status.Verification == nil {
+		now := meta.Now()
+		status.Verification = &backupApi.ArangoBackupStatusVerification{
+			Phase:      backupApi.ArangoBackupVerificationPhaseDeploying,
+			Deployment: verificationDeploymentName(backup),
+			StartTime:  &now,
+		}
+	}
+
+	v := status.Verification
+
+	if v.Phase != backupApi.ArangoBackupVerificationPhaseCleaningUp {
+		if s := v.StartTime; s != nil && time.Since(s.Time) > spec.GetTimeout() {
+			h.finishBackupVerification(backup, status, errors.Newf("timeout of %s exceeded", spec.GetTimeout().String()))
+			return status
+		}
+	}
+
+	var err error
+
+	switch v.Phase {
+	case backupApi.ArangoBackupVerificationPhaseDeploying:
+		err = h.verificationDeploy(backup, status)
+	case backupApi.ArangoBackupVerificationPhaseRestoring:
+		err = h.verificationRestore(backup, status)
+	case backupApi.ArangoBackupVerificationPhaseChecking:
+		err = h.verificationCheck(backup, status)
+	case backupApi.ArangoBackupVerificationPhaseCleaningUp:
+		h.verificationCleanup(backup, status)
+		return status
+	default:
+		err = errors.Newf("unknown verification phase %s", v.Phase)
+	}
+
+	if err != nil {
+		h.finishBackupVerification(backup, status, err)
+	}
+
+	return status
+}
+
+// verificationDeploy creates scratch deployment and ArangoBackup which downloads the backup into it
+func (h *handler) verificationDeploy(backup *backupApi.ArangoBackup, status *backupApi.ArangoBackupStatus) error {
+	v := status.Verification
+
+	ctx, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(context.Background())
+	defer cancel()
+
+	deployments := h.client.DatabaseV1().ArangoDeployments(backup.GetNamespace())
+
+	if existing, err := deployments.Get(ctx, v.Deployment, meta.GetOptions{}); err != nil {
+		if !apiErrors.IsNotFound(err) {
+			v.Message = err.Error()
+			return nil
+		}
+
+		source, err := h.getArangoDeploymentObject(backup)
+		if err != nil {
+			v.Message = err.Error()
+			return nil
+		}
+
+		if _, err := deployments.Create(ctx, newVerificationDeployment(backup, source, v.Deployment), meta.CreateOptions{}); err != nil {
+			return errors.Wrapf(err, "unable to create scratch deployment")
+		}
+	} else if !isVerificationObject(backup, existing) {
+		return errors.Newf("deployment %s is not a scratch deployment of the backup", v.Deployment)
+	}
+
+	backups := h.client.BackupV1().ArangoBackups(backup.GetNamespace())
+
+	download, err := backups.Get(ctx, v.Deployment, meta.GetOptions{})
+	if err != nil {
+		if !apiErrors.IsNotFound(err) {
+			v.Message = err.Error()
+			return nil
+		}
+
+		if _, err := backups.Create(ctx, newVerificationBackup(backup, status, v.Deployment), meta.CreateOptions{}); err != nil {
+			return errors.Wrapf(err, "unable to create download backup")
+		}
+
+		v.Message = "waiting for backup download"
+		return nil
+	}
+
+	switch download.Status.State {
+	case backupApi.ArangoBackupStateReady:
+		if download.Status.Backup == nil {
+			v.Message = "waiting for backup download"
+			return nil
+		}
+	case backupApi.ArangoBackupStateFailed, backupApi.ArangoBackupStateDownloadError:
+		return errors.Newf("backup download failed: %s", download.Status.Message)
+	default:
+		v.Message = "waiting for backup download"
+		return nil
+	}
+
+	deployment, err := deployments.Get(ctx, v.Deployment, meta.GetOptions{})
+	if err != nil {
+		v.Message = err.Error()
+		return nil
+	}
+
+	if !isVerificationObject(backup, deployment) {
+		return errors.Newf("deployment %s is not a scratch deployment of the backup", v.Deployment)
+	}
+
+	deployment.Spec.RestoreFrom = util.NewString(download.GetName())
+
+	if _, err := deployments.Update(ctx, deployment, meta.UpdateOptions{}); err != nil {
+		v.Message = err.Error()
+		return nil
+	}
+
+	v.Phase = backupApi.ArangoBackupVerificationPhaseRestoring
+	v.Message = ""
+	return nil
+}
+
+// verificationRestore waits until scratch deployment reports restore result
+func (h *handler) verificationRestore(backup *backupApi.ArangoBackup, status *backupApi.ArangoBackupStatus) error {
+	v := status.Verification
+
+	ctx, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(context.Background())
+	defer cancel()
+
+	deployment, err := h.client.DatabaseV1().ArangoDeployments(backup.GetNamespace()).Get(ctx, v.Deployment, meta.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return errors.Newf("scratch deployment %s is missing", v.Deployment)
+		}
+
+		v.Message = err.Error()
+		return nil
+	}
+
+	restore := deployment.Status.Restore
+	if restore == nil {
+		v.Message = "waiting for restore"
+		return nil
+	}
+
+	switch restore.State {
+	case database.DeploymentRestoreStateRestored:
+		v.Phase = backupApi.ArangoBackupVerificationPhaseChecking
+		v.Message = ""
+	case database.DeploymentRestoreStateRestoreFailed:
+		return errors.Newf("restore failed: %s", restore.Message)
+	default:
+		v.Message = "waiting for restore"
+	}
+
+	return nil
+}
+
+// verificationCheck runs collection checks and optional ArangoJob against the scratch deployment
+func (h *handler) verificationCheck(backup *backupApi.ArangoBackup, status *backupApi.ArangoBackupStatus) error {
+	v := status.Verification
+	spec := backup.Spec.Verification
+
+	ctx, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(context.Background())
+	defer cancel()
+
+	deployment, err := h.client.DatabaseV1().ArangoDeployments(backup.GetNamespace()).Get(ctx, v.Deployment, meta.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return errors.Newf("scratch deployment %s is missing", v.Deployment)
+		}
+
+		v.Message = err.Error()
+		return nil
+	}
+
+	if len(spec.Collections) > 0 {
+		client, err := h.arangoClientFactory(deployment, backup)
+		if err != nil {
+			v.Message = err.Error()
+			return nil
+		}
+
+		for _, c := range spec.Collections {
+			count, err := client.CollectionCount(c.Database, c.Collection)
+			if err != nil {
+				return errors.Wrapf(err, "unable to count documents in %s/%s", c.Database, c.Collection)
+			}
+
+			if count < c.GetMinCount() {
+				return errors.Newf("collection %s/%s contains %d documents, expected at least %d", c.Database, c.Collection, count, c.GetMinCount())
+			}
+		}
+	}
+
+	if spec.JobTemplate != nil {
+		jobs := h.client.AppsV1().ArangoJobs(backup.GetNamespace())
+
+		job, err := jobs.Get(ctx, v.Deployment, meta.GetOptions{})
+		if err != nil {
+			if !apiErrors.IsNotFound(err) {
+				v.Message = err.Error()
+				return nil
+			}
+
+			if _, err := jobs.Create(ctx, newVerificationJob(backup, spec, v.Deployment), meta.CreateOptions{}); err != nil {
+				return errors.Wrapf(err, "unable to create verification job")
+			}
+
+			v.Message = "waiting for verification job"
+			return nil
+		}
+
+		completed := false
+		for _, c := range job.Status.Conditions {
+			if c.Status != core.ConditionTrue {
+				continue
+			}
+
+			switch c.Type {
+			case batch.JobComplete:
+				completed = true
+			case batch.JobFailed:
+				return errors.Newf("verification job failed: %s", c.Message)
+			}
+		}
+
+		if !completed {
+			v.Message = "waiting for verification job"
+			return nil
+		}
+	}
+
+	h.finishBackupVerification(backup, status, nil)
+
+	return nil
+}
+
+// verificationCleanup removes all objects created during verification
+func (h *handler) verificationCleanup(backup *backupApi.ArangoBackup, status *backupApi.ArangoBackupStatus) {
+	v := status.Verification
+
+	ctx, cancel := globals.GetGlobalTimeouts().Kubernetes().WithTimeout(context.Background())
+	defer cancel()
+
+	if err := h.client.AppsV1().ArangoJobs(backup.GetNamespace()).Delete(ctx, v.Deployment, meta.DeleteOptions{}); err != nil && !apiErrors.IsNotFound(err) {
+		v.Message = err.Error()
+		return
+	}
+
+	// Backup needs to be removed before deployment, so finalizer is able to reach the database
+	backups := h.client.BackupV1().ArangoBackups(backup.GetNamespace())
+	if _, err := backups.Get(ctx, v.Deployment, meta.GetOptions{}); err == nil {
+		if err := backups.Delete(ctx, v.Deployment, meta.DeleteOptions{}); err != nil && !apiErrors.IsNotFound(err) {
+			v.Message = err.Error()
+		}
+		return
+	} else if !apiErrors.IsNotFound(err) {
+		v.Message = err.Error()
+		return
+	}
+
+	deployments := h.client.DatabaseV1().ArangoDeployments(backup.GetNamespace())
+	if deployment, err := deployments.Get(ctx, v.Deployment, meta.GetOptions{}); err == nil {
+		// Deployment which was not created by the verification is never removed
+		if isVerificationObject(backup, deployment) {
+			if err := deployments.Delete(ctx, v.Deployment, meta.DeleteOptions{}); err != nil && !apiErrors.IsNotFound(err) {
+				v.Message = err.Error()
+			}
+			return
+		}
+	} else if !apiErrors.IsNotFound(err) {
+		v.Message = err.Error()
+		return
+	}
+
+	now := meta.Now()
+	v.Phase = backupApi.ArangoBackupVerificationPhaseCompleted
+	v.CompletionTime = &now
+}
+
+// finishBackupVerification records result in the Verified condition and moves verification into CleaningUp phase
+func (h *handler) finishBackupVerification(backup *backupApi.ArangoBackup, status *backupApi.ArangoBackupStatus, err error) {
+	v := status.Verification
+	v.Phase = backupApi.ArangoBackupVerificationPhaseCleaningUp
+
+	if err != nil {
+		v.Message = err.Error()
+		status.Conditions.Update(backupApi.ConditionTypeBackupVerified, false, "VerificationFailed", err.Error())
+		h.eventRecorder.Warning(backup, BackupVerification, "Backup verification failed: %s", err.Error())
+		return
+	}
+
+	v.Message = ""
+	status.Conditions.Update(backupApi.ConditionTypeBackupVerified, true, "VerificationSucceeded", "Backup restored and checks passed")
+	h.eventRecorder.Normal(backup, BackupVerification, "Backup verification succeeded")
+}
+
+// verificationLabels returns labels of the objects created for the backup verification.
+// Labeled deployments are skipped by the ArangoBackupPolicy.
+func verificationLabels(backup *backupApi.ArangoBackup) map[string]string {
+	return map[string]string{
+		k8sutil.LabelKeyArangoBackupVerification: string(backup.GetUID()),
+	}
+}
+
+// isVerificationObject returns true if object was created for the verification of the backup
+func isVerificationObject(backup *backupApi.ArangoBackup, obj meta.Object) bool {
+	v, ok := obj.GetLabels()[k8sutil.LabelKeyArangoBackupVerification]
+	return ok && v == string(backup.GetUID())
+}
+
+func verificationDeploymentName(backup *backupApi.ArangoBackup) string {
+	return fmt.Sprintf("verify-%s", util.SHA256FromString(fmt.Sprintf("%s/%s", backup.GetNamespace(), backup.GetName()))[0:8])
+}
+
+// newVerificationDeployment creates deployment with the topology of the source deployment, without external access and sync
+func newVerificationDeployment(backup *backupApi.ArangoBackup, source *database.ArangoDeployment, name string) *database.ArangoDeployment {
+	spec := source.Spec.DeepCopy()
+
+	spec.RestoreFrom = nil
+	spec.RestoreEncryptionSecret = nil
+	spec.ExternalAccess.Type = database.NewExternalAccessType(database.ExternalAccessTypeNone)
+	spec.Sync.Enabled = util.NewBool(false)
+	spec.Authentication.JWTSecretName = nil
+	spec.TLS.CASecretName = nil
+
+	return &database.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: backup.GetNamespace(),
+			Labels:    verificationLabels(backup),
+			OwnerReferences: []meta.OwnerReference{
+				backup.AsOwner(),
+			},
+		},
+		Spec: *spec,
+	}
+}
+
+// newVerificationBackup creates ArangoBackup which downloads uploaded backup into the scratch deployment
+func newVerificationBackup(backup *backupApi.ArangoBackup, status *backupApi.ArangoBackupStatus, name string) *backupApi.ArangoBackup {
+	return &backupApi.ArangoBackup{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: backup.GetNamespace(),
+			Labels:    verificationLabels(backup),
+			OwnerReferences: []meta.OwnerReference{
+				backup.AsOwner(),
+			},
+		},
+		Spec: backupApi.ArangoBackupSpec{
+			Deployment: backupApi.ArangoBackupSpecDeployment{
+				Name: name,
+			},
+			Download: &backupApi.ArangoBackupSpecDownload{
+				ArangoBackupSpecOperation: *backup.Spec.Upload.DeepCopy(),
+				ID:                        status.Backup.ID,
+			},
+		},
+	}
+}
+
+func newVerificationJob(backup *backupApi.ArangoBackup, spec *backupApi.ArangoBackupSpecVerification, name string) *appsApi.ArangoJob {
+	return &appsApi.ArangoJob{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: backup.GetNamespace(),
+			Labels:    verificationLabels(backup),
+			OwnerReferences: []meta.OwnerReference{
+				backup.AsOwner(),
+			},
+		},
+		Spec: appsApi.ArangoJobSpec{
+			ArangoDeploymentName: name,
+			JobTemplate:          spec.JobTemplate.DeepCopy(),
+		},
+	}
+}
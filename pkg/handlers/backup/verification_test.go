@@ -0,0 +1,221 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	database "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+func newVerificationObjectSet(t *testing.T, minCount int64) (*handler, *mockArangoClientBackupState, *backupApi.ArangoBackup) {
+	handler, mock := newErrorsFakeHandler(mockErrorsArangoClientBackup{})
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateReady)
+
+	createResponse, err := mock.Create()
+	require.NoError(t, err)
+
+	backupMeta, err := mock.Get(createResponse.ID)
+	require.NoError(t, err)
+
+	obj.Spec.Upload = &backupApi.ArangoBackupSpecOperation{
+		RepositoryURL: "s3://test",
+	}
+	obj.Spec.Verification = &backupApi.ArangoBackupSpecVerification{
+		Collections: []backupApi.ArangoBackupVerificationCollection{
+			{
+				Database:   "_system",
+				Collection: "test",
+				MinCount:   util.NewInt64(minCount),
+			},
+		},
+	}
+
+	obj.Status.Backup = createBackupFromMeta(backupMeta, nil)
+	obj.Status.Backup.Uploaded = util.NewBool(true)
+
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+
+	return handler, mock.state, obj
+}
+
+func runVerificationUntilChecking(t *testing.T, handler *handler, obj *backupApi.ArangoBackup) string {
+	// Deploying
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	newObj := refreshArangoBackup(t, handler, obj)
+	require.NotNil(t, newObj.Status.Verification)
+	require.Equal(t, backupApi.ArangoBackupVerificationPhaseDeploying, newObj.Status.Verification.Phase)
+
+	name := newObj.Status.Verification.Deployment
+
+	scratch, err := handler.client.DatabaseV1().ArangoDeployments(obj.Namespace).Get(context.Background(), name, meta.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, database.ExternalAccessTypeNone, scratch.Spec.ExternalAccess.GetType())
+	require.Nil(t, scratch.Spec.RestoreFrom)
+	require.Equal(t, string(obj.UID), scratch.Labels[k8sutil.LabelKeyArangoBackupVerification])
+
+	download, err := handler.client.BackupV1().ArangoBackups(obj.Namespace).Get(context.Background(), name, meta.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, name, download.Spec.Deployment.Name)
+	require.NotNil(t, download.Spec.Download)
+	require.Equal(t, obj.Status.Backup.ID, download.Spec.Download.ID)
+
+	// Download finished
+	download.Status.State = backupApi.ArangoBackupStateReady
+	download.Status.Backup = obj.Status.Backup.DeepCopy()
+	_, err = handler.client.BackupV1().ArangoBackups(obj.Namespace).UpdateStatus(context.Background(), download, meta.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	newObj = refreshArangoBackup(t, handler, obj)
+	require.Equal(t, backupApi.ArangoBackupVerificationPhaseRestoring, newObj.Status.Verification.Phase)
+
+	scratch, err = handler.client.DatabaseV1().ArangoDeployments(obj.Namespace).Get(context.Background(), name, meta.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, scratch.Spec.RestoreFrom)
+	require.Equal(t, name, *scratch.Spec.RestoreFrom)
+
+	// Restore finished
+	scratch.Status.Restore = &database.DeploymentRestoreResult{
+		RequestedFrom: name,
+		State:         database.DeploymentRestoreStateRestored,
+	}
+	_, err = handler.client.DatabaseV1().ArangoDeployments(obj.Namespace).UpdateStatus(context.Background(), scratch, meta.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	newObj = refreshArangoBackup(t, handler, obj)
+	require.Equal(t, backupApi.ArangoBackupVerificationPhaseChecking, newObj.Status.Verification.Phase)
+
+	return name
+}
+
+func runVerificationCleanup(t *testing.T, handler *handler, obj *backupApi.ArangoBackup, name string) {
+	for i := 0; i < 3; i++ {
+		require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+	}
+
+	newObj := refreshArangoBackup(t, handler, obj)
+	require.Equal(t, backupApi.ArangoBackupVerificationPhaseCompleted, newObj.Status.Verification.Phase)
+	require.NotNil(t, newObj.Status.Verification.CompletionTime)
+
+	_, err := handler.client.BackupV1().ArangoBackups(obj.Namespace).Get(context.Background(), name, meta.GetOptions{})
+	require.Error(t, err)
+
+	_, err = handler.client.DatabaseV1().ArangoDeployments(obj.Namespace).Get(context.Background(), name, meta.GetOptions{})
+	require.Error(t, err)
+}
+
+func Test_Verification_Success(t *testing.T) {
+	// Arrange
+	handler, mock, obj := newVerificationObjectSet(t, 10)
+	mock.collections["_system/test"] = 20
+
+	// Act
+	name := runVerificationUntilChecking(t, handler, obj)
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	require.Equal(t, backupApi.ArangoBackupVerificationPhaseCleaningUp, newObj.Status.Verification.Phase)
+	require.True(t, newObj.Status.Conditions.IsTrue(backupApi.ConditionTypeBackupVerified))
+
+	runVerificationCleanup(t, handler, obj, name)
+}
+
+func Test_Verification_CollectionCountMismatch(t *testing.T) {
+	// Arrange
+	handler, mock, obj := newVerificationObjectSet(t, 10)
+	mock.collections["_system/test"] = 5
+
+	// Act
+	name := runVerificationUntilChecking(t, handler, obj)
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	require.Equal(t, backupApi.ArangoBackupVerificationPhaseCleaningUp, newObj.Status.Verification.Phase)
+
+	c, ok := newObj.Status.Conditions.Get(backupApi.ConditionTypeBackupVerified)
+	require.True(t, ok)
+	require.False(t, c.IsTrue())
+	require.Contains(t, c.Message, "expected at least 10")
+
+	runVerificationCleanup(t, handler, obj, name)
+}
+
+func Test_Verification_ExistingDeployment(t *testing.T) {
+	// Arrange
+	handler, _, obj := newVerificationObjectSet(t, 10)
+
+	name := verificationDeploymentName(obj)
+	_, deployment := newObjectSet(backupApi.ArangoBackupStateNone)
+	deployment.Name = name
+	deployment.Namespace = obj.Namespace
+	createArangoDeployment(t, handler, deployment)
+
+	// Act
+	for i := 0; i < 3; i++ {
+		require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+	}
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	require.Equal(t, backupApi.ArangoBackupVerificationPhaseCompleted, newObj.Status.Verification.Phase)
+	require.False(t, newObj.Status.Conditions.IsTrue(backupApi.ConditionTypeBackupVerified))
+	require.Contains(t, newObj.Status.Verification.Message, "is not a scratch deployment")
+
+	// Deployment which is not owned by the verification is kept
+	_, err := handler.client.DatabaseV1().ArangoDeployments(obj.Namespace).Get(context.Background(), name, meta.GetOptions{})
+	require.NoError(t, err)
+}
+
+func Test_Verification_RequiresUpload(t *testing.T) {
+	spec := backupApi.ArangoBackupSpec{
+		Deployment: backupApi.ArangoBackupSpecDeployment{
+			Name: "test",
+		},
+		Verification: &backupApi.ArangoBackupSpecVerification{},
+	}
+
+	require.EqualError(t, spec.Validate(), "verification requires upload to be defined")
+
+	spec.Upload = &backupApi.ArangoBackupSpecOperation{
+		RepositoryURL: "s3://test",
+	}
+
+	require.NoError(t, spec.Validate())
+}
@@ -27,7 +27,10 @@ import (
 	"time"
 
 	"github.com/robfig/cron"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/arangodb/kube-arangodb/pkg/apis/backup"
@@ -36,12 +39,17 @@ import (
 	operator "github.com/arangodb/kube-arangodb/pkg/operatorV2"
 	"github.com/arangodb/kube-arangodb/pkg/operatorV2/event"
 	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
 const (
-	backupCreated = "ArangoBackupCreated"
-	policyError   = "Error"
-	rescheduled   = "Rescheduled"
+	backupCreated  = "ArangoBackupCreated"
+	backupPruned   = "ArangoBackupPruned"
+	backupReplaced = "ArangoBackupReplaced"
+	backupSkipped  = "ArangoBackupSkipped"
+	policyError    = "Error"
+	rescheduled    = "Rescheduled"
+	missedSchedule = "MissedSchedule"
 )
 
 type handler struct {
@@ -68,7 +76,15 @@ func (h *handler) Handle(item operation.Item) error {
 		return err
 	}
 
-	status := h.processBackupPolicy(policy.DeepCopy())
+	// List backups once, they are shared by the concurrency policy, retention and deployments status
+	backups, err := h.client.BackupV1().ArangoBackups(item.Namespace).List(context.Background(), meta.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	status, scheduled := h.processBackupPolicy(policy.DeepCopy(), backups.Items)
+	status.Retention = h.processBackupPolicyRetention(policy.DeepCopy(), backups.Items)
+	status.Deployments = h.processBackupPolicyDeployments(policy.DeepCopy(), scheduled, backups.Items)
 	// Nothing to update, objects are equal
 	if reflect.DeepEqual(policy.Status, status) {
 		return nil
@@ -84,13 +100,14 @@ func (h *handler) Handle(item operation.Item) error {
 	return nil
 }
 
-func (h *handler) processBackupPolicy(policy *backupApi.ArangoBackupPolicy) backupApi.ArangoBackupPolicyStatus {
+// processBackupPolicy returns new status of the policy and the time of scheduling for each deployment which got new backup
+func (h *handler) processBackupPolicy(policy *backupApi.ArangoBackupPolicy, backups []backupApi.ArangoBackup) (backupApi.ArangoBackupPolicyStatus, map[string]meta.Time) {
 	if err := policy.Validate(); err != nil {
 		h.eventRecorder.Warning(policy, policyError, "Policy Error: %s", err.Error())
 
 		return backupApi.ArangoBackupPolicyStatus{
 			Message: fmt.Sprintf("Validation error: %s", err.Error()),
-		}
+		}, nil
 	}
 
 	now := time.Now()
@@ -101,7 +118,7 @@ func (h *handler) processBackupPolicy(policy *backupApi.ArangoBackupPolicy) back
 
 		return backupApi.ArangoBackupPolicyStatus{
 			Message: fmt.Sprintf("error while parsing expr: %s", err.Error()),
-		}
+		}, nil
 	}
 
 	if policy.Status.Scheduled.IsZero() {
@@ -111,7 +128,7 @@ func (h *handler) processBackupPolicy(policy *backupApi.ArangoBackupPolicy) back
 			Scheduled: meta.Time{
 				Time: next,
 			},
-		}
+		}, nil
 	}
 
 	// Check if schedule is required
@@ -125,23 +142,52 @@ func (h *handler) processBackupPolicy(policy *backupApi.ArangoBackupPolicy) back
 				Scheduled: meta.Time{
 					Time: next,
 				},
-			}
+			}, nil
 		}
 
-		return policy.Status
+		return policy.Status, nil
+	}
+
+	// Skip run if policy is suspended
+	if policy.Spec.GetSuspend() {
+		next := expr.Next(now)
+
+		h.eventRecorder.Normal(policy, rescheduled, "Policy is suspended, rescheduled for: %s", next.String())
+
+		return backupApi.ArangoBackupPolicyStatus{
+			Scheduled: meta.Time{
+				Time: next,
+			},
+		}, nil
+	}
+
+	// Skip run if starting deadline is exceeded
+	if deadline := policy.Spec.GetStartingDeadline(); deadline > 0 && now.Sub(policy.Status.Scheduled.Time) > deadline {
+		next := expr.Next(now)
+
+		h.eventRecorder.Warning(policy, missedSchedule, "Missed schedule at %s, starting deadline exceeded. Rescheduled for: %s", policy.Status.Scheduled.String(), next.String())
+
+		return backupApi.ArangoBackupPolicyStatus{
+			Scheduled: meta.Time{
+				Time: next,
+			},
+		}, nil
 	}
 
 	// Schedule new deployments
-	listOptions := meta.ListOptions{}
+	selector, err := policyDeploymentSelector(policy.Spec.DeploymentSelector)
+	if err != nil {
+		h.eventRecorder.Warning(policy, policyError, "Policy Error: %s", err.Error())
 
-	if policy.Spec.DeploymentSelector != nil &&
-		(policy.Spec.DeploymentSelector.MatchLabels != nil &&
-			len(policy.Spec.DeploymentSelector.MatchLabels) > 0 ||
-			policy.Spec.DeploymentSelector.MatchExpressions != nil) {
-		listOptions.LabelSelector = meta.FormatLabelSelector(policy.Spec.DeploymentSelector)
+		return backupApi.ArangoBackupPolicyStatus{
+			Scheduled: policy.Status.Scheduled,
+			Message:   fmt.Sprintf("invalid selector: %s", err.Error()),
+		}, nil
 	}
 
-	deployments, err := h.client.DatabaseV1().ArangoDeployments(policy.Namespace).List(context.Background(), listOptions)
+	deployments, err := h.client.DatabaseV1().ArangoDeployments(policy.Namespace).List(context.Background(), meta.ListOptions{
+		LabelSelector: selector.String(),
+	})
 
 	if err != nil {
 		h.eventRecorder.Warning(policy, policyError, "Policy Error: %s", err.Error())
@@ -149,10 +195,38 @@ func (h *handler) processBackupPolicy(policy *backupApi.ArangoBackupPolicy) back
 		return backupApi.ArangoBackupPolicyStatus{
 			Scheduled: policy.Status.Scheduled,
 			Message:   fmt.Sprintf("deployments listing failed: %s", err.Error()),
-		}
+		}, nil
 	}
 
+	var running map[string][]backupApi.ArangoBackup
+
+	if policy.Spec.ConcurrencyPolicy.Get() != backupApi.ArangoBackupPolicyConcurrencyPolicyAllow {
+		running = groupPolicyBackups(policy, filterRunningBackups(backups))
+	}
+
+	scheduled := map[string]meta.Time{}
+
 	for _, deployment := range deployments.Items {
+		if inProgress := running[deployment.Name]; len(inProgress) > 0 {
+			if policy.Spec.ConcurrencyPolicy.Get() == backupApi.ArangoBackupPolicyConcurrencyPolicyForbid {
+				h.eventRecorder.Normal(policy, backupSkipped, "Skipped ArangoBackup for %s/%s, ArangoBackup %s is still in progress", deployment.Namespace, deployment.Name, inProgress[0].Name)
+				continue
+			}
+
+			for _, b := range inProgress {
+				if err := h.client.BackupV1().ArangoBackups(b.Namespace).Delete(context.Background(), b.Name, meta.DeleteOptions{}); err != nil && !apiErrors.IsNotFound(err) {
+					h.eventRecorder.Warning(policy, policyError, "Policy Error: %s", err.Error())
+
+					return backupApi.ArangoBackupPolicyStatus{
+						Scheduled: policy.Status.Scheduled,
+						Message:   fmt.Sprintf("backup removal failed: %s", err.Error()),
+					}, scheduled
+				}
+
+				h.eventRecorder.Normal(policy, backupReplaced, "Removed ArangoBackup in progress: %s/%s", b.Namespace, b.Name)
+			}
+		}
+
 		b := policy.NewBackup(deployment.DeepCopy())
 
 		if _, err := h.client.BackupV1().ArangoBackups(b.Namespace).Create(context.Background(), b, meta.CreateOptions{}); err != nil {
@@ -161,7 +235,11 @@ func (h *handler) processBackupPolicy(policy *backupApi.ArangoBackupPolicy) back
 			return backupApi.ArangoBackupPolicyStatus{
 				Scheduled: policy.Status.Scheduled,
 				Message:   fmt.Sprintf("backup creation failed: %s", err.Error()),
-			}
+			}, scheduled
+		}
+
+		scheduled[deployment.Name] = meta.Time{
+			Time: now,
 		}
 
 		h.eventRecorder.Normal(policy, backupCreated, "Created ArangoBackup: %s/%s", b.Namespace, b.Name)
@@ -175,7 +253,7 @@ func (h *handler) processBackupPolicy(policy *backupApi.ArangoBackupPolicy) back
 		Scheduled: meta.Time{
 			Time: next,
 		},
-	}
+	}, scheduled
 }
 
 func (*handler) CanBeHandled(item operation.Item) bool {
@@ -183,3 +261,28 @@ func (*handler) CanBeHandled(item operation.Item) bool {
 		item.Version == backupApi.SchemeGroupVersion.Version &&
 		item.Kind == backup.ArangoBackupPolicyResourceKind
 }
+
+// policyDeploymentSelector returns selector of the deployments covered by the policy.
+// Scratch deployments created by the backup verification are never selected.
+func policyDeploymentSelector(deploymentSelector *meta.LabelSelector) (labels.Selector, error) {
+	selector := labels.Everything()
+
+	if deploymentSelector != nil &&
+		(deploymentSelector.MatchLabels != nil &&
+			len(deploymentSelector.MatchLabels) > 0 ||
+			deploymentSelector.MatchExpressions != nil) {
+		s, err := meta.LabelSelectorAsSelector(deploymentSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		selector = s
+	}
+
+	verification, err := labels.NewRequirement(k8sutil.LabelKeyArangoBackupVerification, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return selector.Add(*verification), nil
+}
@@ -0,0 +1,203 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package policy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+func newPolicyBackup(namespace, deployment, policy string, state backupApi.ArangoBackupState, created time.Time) *backupApi.ArangoBackup {
+	return &backupApi.ArangoBackup{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      string(uuid.NewUUID()),
+			Namespace: namespace,
+		},
+		Spec: backupApi.ArangoBackupSpec{
+			Deployment: backupApi.ArangoBackupSpecDeployment{
+				Name: deployment,
+			},
+			PolicyName: util.NewString(policy),
+		},
+		Status: backupApi.ArangoBackupStatus{
+			ArangoBackupState: state,
+			Backup: &backupApi.ArangoBackupDetails{
+				ID: string(uuid.NewUUID()),
+				CreationTimestamp: meta.Time{
+					Time: created,
+				},
+			},
+		},
+	}
+}
+
+func readyBackups(now time.Time, ages ...time.Duration) []backupApi.ArangoBackup {
+	r := make([]backupApi.ArangoBackup, len(ages))
+
+	for id, age := range ages {
+		r[id] = *newPolicyBackup("test", "deployment", "policy", backupApi.ArangoBackupState{State: backupApi.ArangoBackupStateReady}, now.Add(-age))
+	}
+
+	return r
+}
+
+func Test_Retention_Select(t *testing.T) {
+	now := time.Date(2022, 8, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Disabled", func(t *testing.T) {
+		require.Empty(t, selectBackupsToPrune(nil, readyBackups(now, time.Hour, 2*time.Hour), now))
+		require.Empty(t, selectBackupsToPrune(&backupApi.ArangoBackupPolicyRetention{}, readyBackups(now, time.Hour, 2*time.Hour), now))
+	})
+
+	t.Run("KeepLast", func(t *testing.T) {
+		backups := readyBackups(now, 3*time.Hour, time.Hour, 4*time.Hour, 2*time.Hour)
+
+		pruned := selectBackupsToPrune(&backupApi.ArangoBackupPolicyRetention{KeepLast: util.NewInt(2)}, backups, now)
+		require.Len(t, pruned, 2)
+		require.Equal(t, backups[0].Name, pruned[0].Name)
+		require.Equal(t, backups[2].Name, pruned[1].Name)
+	})
+
+	t.Run("MaxAge", func(t *testing.T) {
+		backups := readyBackups(now, time.Hour, 25*time.Hour, 49*time.Hour)
+
+		pruned := selectBackupsToPrune(&backupApi.ArangoBackupPolicyRetention{MaxAge: &meta.Duration{Duration: 24 * time.Hour}}, backups, now)
+		require.Len(t, pruned, 2)
+		require.Equal(t, backups[1].Name, pruned[0].Name)
+		require.Equal(t, backups[2].Name, pruned[1].Name)
+	})
+
+	t.Run("KeepLast with MaxAge", func(t *testing.T) {
+		backups := readyBackups(now, 30*time.Hour, 40*time.Hour, 50*time.Hour)
+
+		pruned := selectBackupsToPrune(&backupApi.ArangoBackupPolicyRetention{
+			KeepLast: util.NewInt(1),
+			MaxAge:   &meta.Duration{Duration: 24 * time.Hour},
+		}, backups, now)
+		require.Len(t, pruned, 2)
+		require.Equal(t, backups[1].Name, pruned[0].Name)
+		require.Equal(t, backups[2].Name, pruned[1].Name)
+	})
+
+	t.Run("KeepDaily", func(t *testing.T) {
+		// Two backups per day for three days
+		backups := readyBackups(now, time.Hour, 2*time.Hour, 25*time.Hour, 26*time.Hour, 49*time.Hour, 50*time.Hour)
+
+		pruned := selectBackupsToPrune(&backupApi.ArangoBackupPolicyRetention{KeepDaily: util.NewInt(2)}, backups, now)
+		require.Len(t, pruned, 4)
+
+		for _, p := range pruned {
+			require.NotEqual(t, backups[0].Name, p.Name)
+			require.NotEqual(t, backups[2].Name, p.Name)
+		}
+	})
+
+	t.Run("KeepWeekly", func(t *testing.T) {
+		day := 24 * time.Hour
+		backups := readyBackups(now, day, 2*day, 8*day, 9*day, 15*day)
+
+		pruned := selectBackupsToPrune(&backupApi.ArangoBackupPolicyRetention{KeepWeekly: util.NewInt(2)}, backups, now)
+		require.Len(t, pruned, 3)
+
+		for _, p := range pruned {
+			require.NotEqual(t, backups[0].Name, p.Name)
+			require.NotEqual(t, backups[2].Name, p.Name)
+		}
+	})
+
+	t.Run("Only ready backups", func(t *testing.T) {
+		backups := readyBackups(now, time.Hour, 2*time.Hour, 3*time.Hour)
+		backups[1].Status.State = backupApi.ArangoBackupStateUploading
+
+		pruned := selectBackupsToPrune(&backupApi.ArangoBackupPolicyRetention{KeepLast: util.NewInt(1)}, backups, now)
+		require.Len(t, pruned, 1)
+		require.Equal(t, backups[2].Name, pruned[0].Name)
+	})
+
+	t.Run("Nothing kept", func(t *testing.T) {
+		backups := readyBackups(now, time.Hour, 2*time.Hour)
+
+		require.Empty(t, selectBackupsToPrune(&backupApi.ArangoBackupPolicyRetention{KeepLast: util.NewInt(0)}, backups, now))
+	})
+}
+
+func Test_Retention_Validate(t *testing.T) {
+	require.NoError(t, (&backupApi.ArangoBackupPolicyRetention{KeepLast: util.NewInt(1)}).Validate())
+	require.NoError(t, (&backupApi.ArangoBackupPolicyRetention{KeepLast: util.NewInt(0), KeepDaily: util.NewInt(7)}).Validate())
+	require.Error(t, (&backupApi.ArangoBackupPolicyRetention{KeepLast: util.NewInt(0)}).Validate())
+	require.Error(t, (&backupApi.ArangoBackupPolicyRetention{KeepLast: util.NewInt(0), MaxAge: &meta.Duration{}}).Validate())
+	require.Error(t, (&backupApi.ArangoBackupPolicyRetention{KeepLast: util.NewInt(-1)}).Validate())
+	require.Error(t, (&backupApi.ArangoBackupPolicyRetention{KeepDaily: util.NewInt(-1)}).Validate())
+	require.Error(t, (&backupApi.ArangoBackupPolicyRetention{KeepWeekly: util.NewInt(-1)}).Validate())
+	require.Error(t, (&backupApi.ArangoBackupPolicyRetention{MaxAge: &meta.Duration{Duration: -time.Second}}).Validate())
+}
+
+func Test_Retention_Prune(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	name := string(uuid.NewUUID())
+	namespace := string(uuid.NewUUID())
+
+	policy := newArangoBackupPolicy("* * * */2 *", namespace, name, map[string]string{}, backupApi.ArangoBackupTemplate{})
+	policy.Spec.Retention = &backupApi.ArangoBackupPolicyRetention{
+		KeepLast: util.NewInt(1),
+	}
+
+	ready := backupApi.ArangoBackupState{State: backupApi.ArangoBackupStateReady}
+
+	newest := newPolicyBackup(namespace, "first", name, ready, time.Now().Add(-time.Hour))
+	oldest := newPolicyBackup(namespace, "first", name, ready, time.Now().Add(-2*time.Hour))
+	otherDeployment := newPolicyBackup(namespace, "second", name, ready, time.Now().Add(-3*time.Hour))
+	otherPolicy := newPolicyBackup(namespace, "first", "other", ready, time.Now().Add(-4*time.Hour))
+
+	// Act
+	createArangoBackupPolicy(t, handler, policy)
+
+	for _, b := range []*backupApi.ArangoBackup{newest, oldest, otherDeployment, otherPolicy} {
+		_, err := handler.client.BackupV1().ArangoBackups(namespace).Create(context.Background(), b, meta.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, handler.Handle(newItemFromBackupPolicy(operation.Update, policy)))
+
+	// Assert
+	newPolicy := refreshArangoBackupPolicy(t, handler, policy)
+	require.NotNil(t, newPolicy.Status.Retention)
+	require.Equal(t, 1, newPolicy.Status.Retention.Pruned)
+	require.NotNil(t, newPolicy.Status.Retention.LastPruned)
+
+	backups := listArangoBackups(t, handler, namespace)
+	require.Len(t, backups, 3)
+
+	for _, b := range backups {
+		require.NotEqual(t, oldest.Name, b.Name)
+	}
+}
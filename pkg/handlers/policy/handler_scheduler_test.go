@@ -21,6 +21,7 @@
 package policy
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -29,7 +30,10 @@ import (
 	"k8s.io/apimachinery/pkg/util/uuid"
 
 	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	fakeClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/fake"
 	"github.com/arangodb/kube-arangodb/pkg/operatorV2/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
 func Test_Scheduler_Schedule(t *testing.T) {
@@ -159,6 +163,39 @@ func Test_Scheduler_Valid_OneObject_Selector(t *testing.T) {
 	require.Equal(t, policy.Name, *backups[0].Spec.PolicyName)
 }
 
+func Test_Scheduler_Valid_SkipVerificationDeployment(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	name := string(uuid.NewUUID())
+	namespace := string(uuid.NewUUID())
+
+	policy := newArangoBackupPolicy("* * * */2 *", namespace, name, map[string]string{}, backupApi.ArangoBackupTemplate{})
+	policy.Status.Scheduled = meta.Time{
+		Time: time.Now().Add(-1 * time.Hour),
+	}
+
+	database := newArangoDeployment(namespace, map[string]string{})
+	verification := newArangoDeployment(namespace, map[string]string{
+		k8sutil.LabelKeyArangoBackupVerification: string(uuid.NewUUID()),
+	})
+
+	// Act
+	createArangoBackupPolicy(t, handler, policy)
+	createArangoDeployment(t, handler, database, verification)
+
+	require.NoError(t, handler.Handle(newItemFromBackupPolicy(operation.Update, policy)))
+
+	// Assert
+	newPolicy := refreshArangoBackupPolicy(t, handler, policy)
+	require.Empty(t, newPolicy.Status.Message)
+
+	backups := listArangoBackups(t, handler, namespace)
+	require.Len(t, backups, 1)
+
+	isInList(t, backups, database)
+}
+
 func Test_Scheduler_Valid_MultipleObject_Selector(t *testing.T) {
 	// Arrange
 	handler := newFakeHandler()
@@ -200,6 +237,237 @@ func Test_Scheduler_Valid_MultipleObject_Selector(t *testing.T) {
 	require.Equal(t, policy.Name, *backups[1].Spec.PolicyName)
 }
 
+func Test_Scheduler_Suspend(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	name := string(uuid.NewUUID())
+	namespace := string(uuid.NewUUID())
+
+	policy := newArangoBackupPolicy("* * * */2 *", namespace, name, map[string]string{}, backupApi.ArangoBackupTemplate{})
+	policy.Spec.Suspend = util.NewBool(true)
+	policy.Status.Scheduled = meta.Time{
+		Time: time.Now().Add(-1 * time.Hour),
+	}
+
+	database := newArangoDeployment(namespace, map[string]string{})
+
+	// Act
+	createArangoBackupPolicy(t, handler, policy)
+	createArangoDeployment(t, handler, database)
+
+	require.NoError(t, handler.Handle(newItemFromBackupPolicy(operation.Update, policy)))
+
+	// Assert
+	newPolicy := refreshArangoBackupPolicy(t, handler, policy)
+	require.Empty(t, newPolicy.Status.Message)
+	require.True(t, newPolicy.Status.Scheduled.Unix() > time.Now().Unix())
+	require.Empty(t, newPolicy.Status.Deployments)
+
+	backups := listArangoBackups(t, handler, namespace)
+	require.Len(t, backups, 0)
+}
+
+func Test_Scheduler_StartingDeadline(t *testing.T) {
+	cases := map[string]struct {
+		missedBy time.Duration
+		backups  int
+	}{
+		"Within deadline": {
+			missedBy: 30 * time.Second,
+			backups:  1,
+		},
+		"Deadline exceeded": {
+			missedBy: time.Hour,
+			backups:  0,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			// Arrange
+			handler := newFakeHandler()
+
+			name := string(uuid.NewUUID())
+			namespace := string(uuid.NewUUID())
+
+			policy := newArangoBackupPolicy("* * * */2 *", namespace, name, map[string]string{}, backupApi.ArangoBackupTemplate{})
+			policy.Spec.StartingDeadlineSeconds = util.NewInt64(300)
+			policy.Status.Scheduled = meta.Time{
+				Time: time.Now().Add(-1 * c.missedBy),
+			}
+
+			database := newArangoDeployment(namespace, map[string]string{})
+
+			// Act
+			createArangoBackupPolicy(t, handler, policy)
+			createArangoDeployment(t, handler, database)
+
+			require.NoError(t, handler.Handle(newItemFromBackupPolicy(operation.Update, policy)))
+
+			// Assert
+			newPolicy := refreshArangoBackupPolicy(t, handler, policy)
+			require.Empty(t, newPolicy.Status.Message)
+			require.True(t, newPolicy.Status.Scheduled.Unix() > time.Now().Unix())
+
+			backups := listArangoBackups(t, handler, namespace)
+			require.Len(t, backups, c.backups)
+		})
+	}
+}
+
+func Test_Scheduler_ConcurrencyPolicy(t *testing.T) {
+	cases := map[backupApi.ArangoBackupPolicyConcurrencyPolicy]struct {
+		backups     int
+		keepRunning bool
+	}{
+		backupApi.ArangoBackupPolicyConcurrencyPolicyAllow: {
+			backups:     2,
+			keepRunning: true,
+		},
+		backupApi.ArangoBackupPolicyConcurrencyPolicyForbid: {
+			backups:     1,
+			keepRunning: true,
+		},
+		backupApi.ArangoBackupPolicyConcurrencyPolicyReplace: {
+			backups:     1,
+			keepRunning: false,
+		},
+	}
+
+	for p, c := range cases {
+		t.Run(string(p), func(t *testing.T) {
+			// Arrange
+			handler := newFakeHandler()
+
+			name := string(uuid.NewUUID())
+			namespace := string(uuid.NewUUID())
+
+			concurrencyPolicy := p
+
+			policy := newArangoBackupPolicy("* * * */2 *", namespace, name, map[string]string{}, backupApi.ArangoBackupTemplate{})
+			policy.Spec.ConcurrencyPolicy = &concurrencyPolicy
+			policy.Status.Scheduled = meta.Time{
+				Time: time.Now().Add(-1 * time.Hour),
+			}
+
+			database := newArangoDeployment(namespace, map[string]string{})
+
+			running := newPolicyBackup(namespace, database.Name, name, backupApi.ArangoBackupState{State: backupApi.ArangoBackupStateUploading}, time.Now().Add(-2*time.Hour))
+
+			// Act
+			createArangoBackupPolicy(t, handler, policy)
+			createArangoDeployment(t, handler, database)
+			_, err := handler.client.BackupV1().ArangoBackups(namespace).Create(context.Background(), running, meta.CreateOptions{})
+			require.NoError(t, err)
+
+			require.NoError(t, handler.Handle(newItemFromBackupPolicy(operation.Update, policy)))
+
+			// Assert
+			newPolicy := refreshArangoBackupPolicy(t, handler, policy)
+			require.Empty(t, newPolicy.Status.Message)
+			require.True(t, newPolicy.Status.Scheduled.Unix() > time.Now().Unix())
+
+			backups := listArangoBackups(t, handler, namespace)
+			require.Len(t, backups, c.backups)
+
+			found := false
+			for _, b := range backups {
+				if b.Name == running.Name {
+					found = true
+				}
+			}
+			require.Equal(t, c.keepRunning, found)
+		})
+	}
+}
+
+func Test_Scheduler_ListBackupsOnce(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	name := string(uuid.NewUUID())
+	namespace := string(uuid.NewUUID())
+
+	concurrencyPolicy := backupApi.ArangoBackupPolicyConcurrencyPolicyForbid
+
+	policy := newArangoBackupPolicy("* * * */2 *", namespace, name, map[string]string{}, backupApi.ArangoBackupTemplate{})
+	policy.Spec.ConcurrencyPolicy = &concurrencyPolicy
+	policy.Spec.Retention = &backupApi.ArangoBackupPolicyRetention{
+		KeepLast: util.NewInt(1),
+	}
+	policy.Status.Scheduled = meta.Time{
+		Time: time.Now().Add(-1 * time.Hour),
+	}
+
+	database := newArangoDeployment(namespace, map[string]string{})
+
+	// Act
+	createArangoBackupPolicy(t, handler, policy)
+	createArangoDeployment(t, handler, database)
+
+	client := handler.client.(*fakeClientSet.Clientset)
+	client.ClearActions()
+
+	require.NoError(t, handler.Handle(newItemFromBackupPolicy(operation.Update, policy)))
+
+	// Assert
+	lists := 0
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "list" && action.GetResource().Resource == "arangobackups" {
+			lists++
+		}
+	}
+	require.Equal(t, 1, lists)
+	require.Len(t, listArangoBackups(t, handler, namespace), 1)
+}
+
+func Test_Scheduler_DeploymentStatus(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	name := string(uuid.NewUUID())
+	namespace := string(uuid.NewUUID())
+
+	policy := newArangoBackupPolicy("* * * */2 *", namespace, name, map[string]string{}, backupApi.ArangoBackupTemplate{})
+	policy.Status.Scheduled = meta.Time{
+		Time: time.Now().Add(-1 * time.Hour),
+	}
+
+	database := newArangoDeployment(namespace, map[string]string{})
+
+	ready := newPolicyBackup(namespace, database.Name, name, backupApi.ArangoBackupState{State: backupApi.ArangoBackupStateReady}, time.Now().Add(-2*time.Hour))
+	olderReady := newPolicyBackup(namespace, database.Name, name, backupApi.ArangoBackupState{State: backupApi.ArangoBackupStateReady}, time.Now().Add(-4*time.Hour))
+	failed := newPolicyBackup(namespace, database.Name, name, backupApi.ArangoBackupState{State: backupApi.ArangoBackupStateFailed, Message: "failure"}, time.Now().Add(-3*time.Hour))
+
+	// Act
+	createArangoBackupPolicy(t, handler, policy)
+	createArangoDeployment(t, handler, database)
+
+	for _, b := range []*backupApi.ArangoBackup{ready, olderReady, failed} {
+		_, err := handler.client.BackupV1().ArangoBackups(namespace).Create(context.Background(), b, meta.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, handler.Handle(newItemFromBackupPolicy(operation.Update, policy)))
+
+	// Assert
+	newPolicy := refreshArangoBackupPolicy(t, handler, policy)
+	require.Empty(t, newPolicy.Status.Message)
+
+	status, ok := newPolicy.Status.Deployments[database.Name]
+	require.True(t, ok)
+
+	require.NotNil(t, status.LastScheduled)
+
+	require.NotNil(t, status.LastSuccessful)
+	require.Equal(t, ready.Name, status.LastSuccessful.Name)
+
+	require.NotNil(t, status.LastFailed)
+	require.Equal(t, failed.Name, status.LastFailed.Name)
+	require.Equal(t, "failure", status.LastFailed.Message)
+}
+
 func Test_Reschedule(t *testing.T) {
 	// Arrange
 	handler := newFakeHandler()
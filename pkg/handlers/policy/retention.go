@@ -0,0 +1,186 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+)
+
+func (h *handler) processBackupPolicyRetention(policy *backupApi.ArangoBackupPolicy, backups []backupApi.ArangoBackup) *backupApi.ArangoBackupPolicyRetentionStatus {
+	status := policy.Status.Retention.DeepCopy()
+
+	if !policy.Spec.Retention.IsEnabled() {
+		return status
+	}
+
+	if err := policy.Spec.Retention.Validate(); err != nil {
+		return status
+	}
+
+	now := time.Now()
+
+	pruned := 0
+
+	for _, deploymentBackups := range groupPolicyBackups(policy, backups) {
+		for _, b := range selectBackupsToPrune(policy.Spec.Retention, deploymentBackups, now) {
+			if err := h.client.BackupV1().ArangoBackups(b.Namespace).Delete(context.Background(), b.Name, meta.DeleteOptions{}); err != nil {
+				if apiErrors.IsNotFound(err) {
+					continue
+				}
+
+				h.eventRecorder.Warning(policy, policyError, "Policy Error: %s", err.Error())
+				continue
+			}
+
+			h.eventRecorder.Normal(policy, backupPruned, "Pruned ArangoBackup: %s/%s", b.Namespace, b.Name)
+			pruned++
+		}
+	}
+
+	if pruned == 0 {
+		return status
+	}
+
+	if status == nil {
+		status = &backupApi.ArangoBackupPolicyRetentionStatus{}
+	}
+
+	status.Pruned += pruned
+	status.LastPruned = &meta.Time{
+		Time: now,
+	}
+
+	return status
+}
+
+// groupPolicyBackups returns backups created by the policy grouped by the deployment name
+func groupPolicyBackups(policy *backupApi.ArangoBackupPolicy, backups []backupApi.ArangoBackup) map[string][]backupApi.ArangoBackup {
+	r := map[string][]backupApi.ArangoBackup{}
+
+	for _, b := range backups {
+		if b.Spec.PolicyName == nil || *b.Spec.PolicyName != policy.Name {
+			continue
+		}
+
+		r[b.Spec.Deployment.Name] = append(r[b.Spec.Deployment.Name], b)
+	}
+
+	return r
+}
+
+// selectBackupsToPrune returns backups which do not match any of the retention rules.
+// Only backups in Ready state are taken into account.
+func selectBackupsToPrune(retention *backupApi.ArangoBackupPolicyRetention, backups []backupApi.ArangoBackup, now time.Time) []backupApi.ArangoBackup {
+	if !retention.IsEnabled() || retention.Validate() != nil {
+		return nil
+	}
+
+	candidates := make([]backupApi.ArangoBackup, 0, len(backups))
+
+	for _, b := range backups {
+		if b.DeletionTimestamp != nil {
+			continue
+		}
+
+		if b.Status.State != backupApi.ArangoBackupStateReady {
+			continue
+		}
+
+		candidates = append(candidates, b)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return backupCreationTime(candidates[i]).After(backupCreationTime(candidates[j]))
+	})
+
+	keep := make([]bool, len(candidates))
+
+	for id := 0; id < len(candidates) && id < retention.GetKeepLast(); id++ {
+		keep[id] = true
+	}
+
+	if maxAge := retention.GetMaxAge(); maxAge > 0 {
+		for id, b := range candidates {
+			if now.Sub(backupCreationTime(b)) <= maxAge {
+				keep[id] = true
+			}
+		}
+	}
+
+	keepNewestInPeriod(candidates, keep, retention.GetKeepDaily(), func(t time.Time) string {
+		return t.UTC().Format("2006-01-02")
+	})
+
+	keepNewestInPeriod(candidates, keep, retention.GetKeepWeekly(), func(t time.Time) string {
+		year, week := t.UTC().ISOWeek()
+		return fmt.Sprintf("%d-%d", year, week)
+	})
+
+	var r []backupApi.ArangoBackup
+
+	for id, b := range candidates {
+		if !keep[id] {
+			r = append(r, b)
+		}
+	}
+
+	return r
+}
+
+// keepNewestInPeriod marks the newest backup in each of the latest periods. Backups need to be sorted from the newest one.
+func keepNewestInPeriod(backups []backupApi.ArangoBackup, keep []bool, periods int, period func(t time.Time) string) {
+	if periods <= 0 {
+		return
+	}
+
+	seen := map[string]bool{}
+
+	for id, b := range backups {
+		p := period(backupCreationTime(b))
+
+		if seen[p] {
+			continue
+		}
+
+		if len(seen) >= periods {
+			return
+		}
+
+		seen[p] = true
+		keep[id] = true
+	}
+}
+
+func backupCreationTime(b backupApi.ArangoBackup) time.Time {
+	if details := b.Status.Backup; details != nil && !details.CreationTimestamp.IsZero() {
+		return details.CreationTimestamp.Time
+	}
+
+	return b.CreationTimestamp.Time
+}
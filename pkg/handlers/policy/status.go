@@ -0,0 +1,124 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package policy
+
+import (
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/handlers/backup/state"
+)
+
+// runningBackupStates contains states of the backup which is not yet created or uploaded
+var runningBackupStates = []state.State{
+	backupApi.ArangoBackupStateNone,
+	backupApi.ArangoBackupStatePending,
+	backupApi.ArangoBackupStateScheduled,
+	backupApi.ArangoBackupStateCreate,
+	backupApi.ArangoBackupStateUpload,
+	backupApi.ArangoBackupStateUploading,
+}
+
+func filterRunningBackups(backups []backupApi.ArangoBackup) []backupApi.ArangoBackup {
+	r := make([]backupApi.ArangoBackup, 0, len(backups))
+
+	for _, b := range backups {
+		if b.DeletionTimestamp != nil {
+			continue
+		}
+
+		for _, s := range runningBackupStates {
+			if b.Status.State == s {
+				r = append(r, b)
+				break
+			}
+		}
+	}
+
+	return r
+}
+
+// processBackupPolicyDeployments returns per deployment status of the policy
+func (h *handler) processBackupPolicyDeployments(policy *backupApi.ArangoBackupPolicy, scheduled map[string]meta.Time, backups []backupApi.ArangoBackup) map[string]backupApi.ArangoBackupPolicyDeploymentStatus {
+	deployments := map[string]backupApi.ArangoBackupPolicyDeploymentStatus{}
+
+	for name, status := range policy.Status.Deployments {
+		deployments[name] = *status.DeepCopy()
+	}
+
+	for name, t := range scheduled {
+		status := deployments[name]
+		status.LastScheduled = t.DeepCopy()
+		deployments[name] = status
+	}
+
+	for name, deploymentBackups := range groupPolicyBackups(policy, backups) {
+		status := deployments[name]
+		status.LastSuccessful = newerBackupStatus(status.LastSuccessful, latestBackupInState(deploymentBackups, backupApi.ArangoBackupStateReady))
+		status.LastFailed = newerBackupStatus(status.LastFailed, latestBackupInState(deploymentBackups, backupApi.ArangoBackupStateFailed))
+		deployments[name] = status
+	}
+
+	if len(deployments) == 0 {
+		return nil
+	}
+
+	return deployments
+}
+
+func latestBackupInState(backups []backupApi.ArangoBackup, s state.State) *backupApi.ArangoBackup {
+	var latest *backupApi.ArangoBackup
+
+	for id := range backups {
+		b := &backups[id]
+
+		if b.Status.State != s {
+			continue
+		}
+
+		if latest == nil || backupCreationTime(*b).After(backupCreationTime(*latest)) {
+			latest = b
+		}
+	}
+
+	return latest
+}
+
+// newerBackupStatus returns status of the backup if it is not older than the current one
+func newerBackupStatus(current *backupApi.ArangoBackupPolicyBackupStatus, b *backupApi.ArangoBackup) *backupApi.ArangoBackupPolicyBackupStatus {
+	if b == nil {
+		return current
+	}
+
+	created := backupCreationTime(*b)
+
+	if current != nil && current.Time.Time.After(created) {
+		return current
+	}
+
+	return &backupApi.ArangoBackupPolicyBackupStatus{
+		Name: b.Name,
+		Time: meta.Time{
+			Time: created,
+		},
+		Message: b.Status.Message,
+	}
+}
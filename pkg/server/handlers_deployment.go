@@ -21,6 +21,7 @@
 package server
 
 import (
+	"context"
 	"net/http"
 	"sort"
 	"strings"
@@ -45,6 +46,10 @@ type Deployment interface {
 	DatabaseURL() string
 	DatabaseVersion() (string, string)
 	Members() map[api.ServerGroup][]Member
+	// PreviewPlan returns the plan which would be created for the given spec, without applying it
+	PreviewPlan(ctx context.Context, spec api.DeploymentSpec) (PlanPreview, error)
+	// PlanHistory returns the latest actions executed by the operator
+	PlanHistory() api.PlanHistory
 }
 
 // Member is the API implemented by a member of an ArangoDeployment.
@@ -207,3 +212,67 @@ func (s *Server) handleGetDeploymentDetails(c *gin.Context) {
 		}
 	}
 }
+
+// PlanPreviewAction contains details of a single action in the previewed plan
+type PlanPreviewAction struct {
+	Type     string `json:"type"`
+	Group    string `json:"group,omitempty"`
+	MemberID string `json:"member_id,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// PlanPreviewMember contains rotation details of a member for the previewed spec
+type PlanPreviewMember struct {
+	Group    string              `json:"group"`
+	ID       string              `json:"id"`
+	Rotation string              `json:"rotation"`
+	Reason   string              `json:"reason,omitempty"`
+	Actions  []PlanPreviewAction `json:"actions,omitempty"`
+}
+
+// PlanPreview contains the plans which would be created by the operator for the previewed spec
+type PlanPreview struct {
+	High      []PlanPreviewAction `json:"high,omitempty"`
+	Resources []PlanPreviewAction `json:"resources,omitempty"`
+	Normal    []PlanPreviewAction `json:"normal,omitempty"`
+	Members   []PlanPreviewMember `json:"members,omitempty"`
+}
+
+// Handle a POST /api/deployment/:name/plan/preview request
+func (s *Server) handlePreviewDeploymentPlan(c *gin.Context) {
+	if do := s.deps.Operators.DeploymentOperator(); do != nil {
+		var req api.ArangoDeployment
+		if err := c.BindJSON(&req); err != nil {
+			sendError(c, err)
+			return
+		}
+		// Fetch deployment
+		depl, err := do.GetDeployment(c.Params.ByName("name"))
+		if err != nil {
+			sendError(c, err)
+		} else if result, err := depl.PreviewPlan(c.Request.Context(), req.Spec); err != nil {
+			sendError(c, err)
+		} else {
+			c.JSON(http.StatusOK, result)
+		}
+	}
+}
+
+// Handle a GET /api/deployment/:name/plan/history request
+func (s *Server) handleGetDeploymentPlanHistory(c *gin.Context) {
+	if do := s.deps.Operators.DeploymentOperator(); do != nil {
+		// Fetch deployment
+		depl, err := do.GetDeployment(c.Params.ByName("name"))
+		if err != nil {
+			sendError(c, err)
+		} else {
+			history := depl.PlanHistory()
+			if member := c.Query("member"); member != "" {
+				history = history.FilterByMember(member)
+			}
+			c.JSON(http.StatusOK, gin.H{
+				"history": history,
+			})
+		}
+	}
+}
@@ -187,6 +187,8 @@ func NewServer(cli typedCore.CoreV1Interface, cfg Config, deps Dependencies) (*S
 		// Deployment operator
 		api.GET("/deployment", s.handleGetDeployments)
 		api.GET("/deployment/:name", s.handleGetDeploymentDetails)
+		api.GET("/deployment/:name/plan/history", s.handleGetDeploymentPlanHistory)
+		api.POST("/deployment/:name/plan/preview", s.handlePreviewDeploymentPlan)
 
 		// Deployment replication operator
 		api.GET("/deployment-replication", s.handleGetDeploymentReplications)
@@ -71,6 +71,23 @@ func (ls *LocalStorage) ensureDaemonSet(apiObject *api.ArangoLocalStorage) error
 		},
 	}
 
+	backend := apiObject.Spec.Backend
+	backendType := backend.GetType()
+	c.Args = append(c.Args, "--backend="+string(backendType))
+	if backendType.IsBlock() {
+		c.Args = append(c.Args, "--fs-type="+backend.GetFSType())
+	}
+	if backendType == api.LocalStorageBackendLVM {
+		lvm := backend.GetLVM()
+		c.Args = append(c.Args,
+			"--lvm-volume-group="+lvm.VolumeGroup,
+			"--lvm-thin-pool="+lvm.ThinPool,
+		)
+	}
+	for _, lp := range apiObject.Spec.LocalPath {
+		c.Args = append(c.Args, "--local-path="+lp)
+	}
+
 	if apiObject.Spec.GetPrivileged() {
 		c.SecurityContext = &core.SecurityContext{
 			Privileged: util.NewBool(true),
@@ -116,6 +133,25 @@ func (ls *LocalStorage) ensureDaemonSet(apiObject *api.ArangoLocalStorage) error
 			},
 		})
 	}
+	for _, m := range getBackendHostMounts(backendType) {
+		c := &dsSpec.Template.Spec.Containers[0]
+		c.VolumeMounts = append(c.VolumeMounts,
+			core.VolumeMount{
+				Name:             m.name,
+				MountPath:        m.mountPath,
+				MountPropagation: &m.propagation,
+			})
+		hostPathType := core.HostPathDirectory
+		dsSpec.Template.Spec.Volumes = append(dsSpec.Template.Spec.Volumes, core.Volume{
+			Name: m.name,
+			VolumeSource: core.VolumeSource{
+				HostPath: &core.HostPathVolumeSource{
+					Path: m.hostPath,
+					Type: &hostPathType,
+				},
+			},
+		})
+	}
 	ds := &apps.DaemonSet{
 		ObjectMeta: meta.ObjectMeta{
 			Name:   apiObject.GetName(),
@@ -164,3 +200,38 @@ func (ls *LocalStorage) ensureDaemonSet(apiObject *api.ArangoLocalStorage) error
 		}
 	}
 }
+
+// backendHostMount defines path of the node required by the provisioner backend
+type backendHostMount struct {
+	name        string
+	hostPath    string
+	mountPath   string
+	propagation core.MountPropagationMode
+}
+
+// getBackendHostMounts returns paths of the node required by the given backend
+func getBackendHostMounts(backendType api.LocalStorageBackendType) []backendHostMount {
+	var mounts []backendHostMount
+
+	if backendType.IsBlock() {
+		// Devices created by the provisioner need to be visible in the container
+		mounts = append(mounts, backendHostMount{
+			name:        "host-dev",
+			hostPath:    "/dev",
+			mountPath:   "/dev",
+			propagation: core.MountPropagationHostToContainer,
+		})
+	}
+
+	if backendType == api.LocalStorageBackendLVM {
+		// LVM tools are executed in the root filesystem of the node
+		mounts = append(mounts, backendHostMount{
+			name:        "host-root",
+			hostPath:    "/",
+			mountPath:   "/host",
+			propagation: core.MountPropagationHostToContainer,
+		})
+	}
+
+	return mounts
+}
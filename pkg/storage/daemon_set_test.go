@@ -96,3 +96,45 @@ func TestEnsureDaemonSet_WithPriority(t *testing.T) {
 	require.NotNil(t, ds.Spec.Template.Spec.Priority)
 	require.Equal(t, priority, *ds.Spec.Template.Spec.Priority)
 }
+
+// TestEnsureDaemonSet_WithLVMBackend tests ensureDaemonSet() method with LVM backend
+func TestEnsureDaemonSet_WithLVMBackend(t *testing.T) {
+	testImage := "test-image"
+	backendType := api.LocalStorageBackendLVM
+
+	_, ds := generateDaemonSet(t, core.PodSpec{
+		Containers: []core.Container{
+			{
+				Name:  testImage,
+				Image: testImage,
+			},
+		},
+	}, api.LocalStorageSpec{
+		LocalPath: []string{"/var/lib/arango"},
+		Backend: &api.LocalStorageBackendSpec{
+			Type: &backendType,
+			LVM: &api.LocalStorageLVMSpec{
+				VolumeGroup: "vg",
+				ThinPool:    "pool",
+			},
+		},
+	})
+
+	require.Equal(t, len(ds.Spec.Template.Spec.Containers), 1)
+
+	c := ds.Spec.Template.Spec.Containers[0]
+	require.Contains(t, c.Args, "--backend=LVM")
+	require.Contains(t, c.Args, "--fs-type=ext4")
+	require.Contains(t, c.Args, "--lvm-volume-group=vg")
+	require.Contains(t, c.Args, "--lvm-thin-pool=pool")
+	require.Contains(t, c.Args, "--local-path=/var/lib/arango")
+
+	mounts := map[string]string{}
+	for _, m := range c.VolumeMounts {
+		mounts[m.MountPath] = m.Name
+	}
+	require.Contains(t, mounts, "/var/lib/arango")
+	require.Contains(t, mounts, "/dev")
+	require.Contains(t, mounts, "/host")
+	require.Len(t, ds.Spec.Template.Spec.Volumes, 3)
+}
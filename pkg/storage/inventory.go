@@ -0,0 +1,171 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// inventoryInterval defines how often inventory of the local paths is refreshed
+	inventoryInterval = time.Minute
+)
+
+// localStorageInventory keeps inventory of the local paths per node name and local path
+type localStorageInventory map[string]map[string]*api.LocalStoragePathStatus
+
+func (l localStorageInventory) get(nodeName, localPath string) *api.LocalStoragePathStatus {
+	paths, ok := l[nodeName]
+	if !ok {
+		paths = map[string]*api.LocalStoragePathStatus{}
+		l[nodeName] = paths
+	}
+
+	path, ok := paths[localPath]
+	if !ok {
+		path = &api.LocalStoragePathStatus{
+			Path: localPath,
+		}
+		paths[localPath] = path
+	}
+
+	return path
+}
+
+// addInfo saves capacity of the filesystem containing the local path
+func (l localStorageInventory) addInfo(localPath string, info provisioner.Info) {
+	path := l.get(info.NodeName, localPath)
+
+	path.Capacity = info.Capacity
+	path.Available = info.Available
+}
+
+// addVolume counts the PersistentVolume in the local path it was created in
+func (l localStorageInventory) addVolume(pv *core.PersistentVolume) {
+	nodeName, ok := pv.GetAnnotations()[nodeNameAnnotation]
+	if !ok || pv.Spec.Local == nil {
+		return
+	}
+
+	path := l.get(nodeName, filepath.Dir(pv.Spec.Local.Path))
+
+	path.Volumes++
+
+	switch pv.Status.Phase {
+	case core.VolumeBound:
+		path.Bound++
+	case core.VolumeReleased:
+		path.Released++
+	}
+}
+
+// asStatus returns inventory sorted by node name and local path
+func (l localStorageInventory) asStatus() api.LocalStorageNodeStatusList {
+	if len(l) == 0 {
+		return nil
+	}
+
+	nodes := make(api.LocalStorageNodeStatusList, 0, len(l))
+
+	for nodeName, paths := range l {
+		node := api.LocalStorageNodeStatus{
+			NodeName: nodeName,
+			Paths:    make([]api.LocalStoragePathStatus, 0, len(paths)),
+		}
+
+		for _, path := range paths {
+			node.Paths = append(node.Paths, *path)
+		}
+
+		sort.Slice(node.Paths, func(i, j int) bool {
+			return node.Paths[i].Path < node.Paths[j].Path
+		})
+
+		nodes = append(nodes, node)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].NodeName < nodes[j].NodeName
+	})
+
+	return nodes
+}
+
+// inspectInventory refreshes the inventory of the local paths on all nodes
+// and saves it in the status.
+func (ls *LocalStorage) inspectInventory(ctx context.Context) error {
+	inventory := localStorageInventory{}
+
+	clients, err := ls.createProvisionerClients()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, c := range clients {
+		for _, localPath := range ls.apiObject.Spec.LocalPath {
+			info, err := c.GetInfo(ctx, localPath)
+			if err != nil {
+				ls.log.Err(err).Str("local-path", localPath).Warn("Failed to get client info")
+				continue
+			}
+
+			inventory.addInfo(localPath, info)
+		}
+	}
+
+	list, err := ls.deps.Client.Kubernetes().CoreV1().PersistentVolumes().List(ctx, meta.ListOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, pv := range list.Items {
+		if pv.Spec.StorageClassName != ls.apiObject.Spec.StorageClass.Name || !ls.isOwnerOf(&pv) {
+			continue
+		}
+
+		inventory.addVolume(&pv)
+	}
+
+	nodes := inventory.asStatus()
+
+	// Metrics are removed once run() exits, which is the only caller of the inspection
+	localStorageMetrics.update(ls.apiObject.GetName(), nodes)
+
+	if reflect.DeepEqual(ls.status.Nodes, nodes) {
+		// Inventory did not change
+		return nil
+	}
+
+	ls.status.Nodes = nodes
+
+	return ls.updateCRStatus()
+}
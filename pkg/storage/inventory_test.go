@@ -0,0 +1,93 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
+)
+
+func newInventoryPV(nodeName, path string, phase core.PersistentVolumePhase) *core.PersistentVolume {
+	return &core.PersistentVolume{
+		ObjectMeta: meta.ObjectMeta{
+			Annotations: map[string]string{
+				nodeNameAnnotation: nodeName,
+			},
+		},
+		Spec: core.PersistentVolumeSpec{
+			PersistentVolumeSource: core.PersistentVolumeSource{
+				Local: &core.LocalVolumeSource{
+					Path: path,
+				},
+			},
+		},
+		Status: core.PersistentVolumeStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func TestLocalStorageInventory(t *testing.T) {
+	inventory := localStorageInventory{}
+
+	require.Nil(t, inventory.asStatus())
+
+	inventory.addInfo("/data", provisioner.Info{NodeInfo: provisioner.NodeInfo{NodeName: "b"}, Capacity: 100, Available: 40})
+	inventory.addInfo("/data", provisioner.Info{NodeInfo: provisioner.NodeInfo{NodeName: "a"}, Capacity: 200, Available: 150})
+	inventory.addInfo("/backup", provisioner.Info{NodeInfo: provisioner.NodeInfo{NodeName: "a"}, Capacity: 50, Available: 50})
+
+	inventory.addVolume(newInventoryPV("b", "/data/abc", core.VolumeBound))
+	inventory.addVolume(newInventoryPV("b", "/data/def", core.VolumeBound))
+	inventory.addVolume(newInventoryPV("b", "/data/ghi", core.VolumeReleased))
+	inventory.addVolume(newInventoryPV("a", "/data/jkl", core.VolumeAvailable))
+	// Node without provisioner
+	inventory.addVolume(newInventoryPV("c", "/data/mno", core.VolumeBound))
+	// Volume without node name
+	inventory.addVolume(&core.PersistentVolume{})
+
+	require.Equal(t, api.LocalStorageNodeStatusList{
+		{
+			NodeName: "a",
+			Paths: []api.LocalStoragePathStatus{
+				{Path: "/backup", Capacity: 50, Available: 50},
+				{Path: "/data", Capacity: 200, Available: 150, Volumes: 1},
+			},
+		},
+		{
+			NodeName: "b",
+			Paths: []api.LocalStoragePathStatus{
+				{Path: "/data", Capacity: 100, Available: 40, Volumes: 3, Bound: 2, Released: 1},
+			},
+		},
+		{
+			NodeName: "c",
+			Paths: []api.LocalStoragePathStatus{
+				{Path: "/data", Volumes: 1, Bound: 1},
+			},
+		},
+	}, inventory.asStatus())
+}
@@ -166,6 +166,9 @@ func (ls *LocalStorage) send(ev *localStorageEvent) {
 func (ls *LocalStorage) run() {
 	//log := ls.log
 
+	// Inventory metrics are updated only by this worker, remove them when it exits
+	defer localStorageMetrics.remove(ls.apiObject.GetName())
+
 	// Find out my image
 	image, pullPolicy, pullSecrets, err := ls.getMyImage()
 	if err != nil {
@@ -205,6 +208,7 @@ func (ls *LocalStorage) run() {
 	inspectionInterval := maxInspectionInterval
 	recentInspectionErrors := 0
 	var pvsNeededSince *time.Time
+	var inventoryUpdated time.Time
 	for {
 		select {
 		case <-ls.stopCh:
@@ -238,6 +242,12 @@ func (ls *LocalStorage) run() {
 				hasError = true
 				ls.createEvent(k8sutil.NewErrorEvent("PV inspection failed", err, ls.apiObject))
 			}
+			if time.Since(inventoryUpdated) > inventoryInterval {
+				if err := ls.inspectInventory(context.Background()); err != nil {
+					ls.log.Err(err).Warn("Failed to inspect inventory")
+				}
+				inventoryUpdated = time.Now()
+			}
 			if len(unboundPVCs) == 0 {
 				pvsNeededSince = nil
 			} else if len(unboundPVCs) > 0 {
@@ -0,0 +1,86 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package storage
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+	"github.com/arangodb/kube-arangodb/pkg/generated/metric_descriptions"
+	"github.com/arangodb/kube-arangodb/pkg/util/metrics"
+)
+
+func init() {
+	prometheus.MustRegister(&localStorageMetrics)
+}
+
+var localStorageMetrics inventoryMetrics
+
+var _ prometheus.Collector = &inventoryMetrics{}
+
+// inventoryMetrics exposes inventory of the local storages
+type inventoryMetrics struct {
+	lock sync.Mutex
+
+	nodes map[string]api.LocalStorageNodeStatusList
+}
+
+func (i *inventoryMetrics) update(name string, nodes api.LocalStorageNodeStatusList) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.nodes == nil {
+		i.nodes = map[string]api.LocalStorageNodeStatusList{}
+	}
+
+	i.nodes[name] = nodes.DeepCopy()
+}
+
+func (i *inventoryMetrics) remove(name string) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	delete(i.nodes, name)
+}
+
+func (i *inventoryMetrics) Describe(descs chan<- *prometheus.Desc) {
+}
+
+func (i *inventoryMetrics) Collect(c chan<- prometheus.Metric) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	m := metrics.NewPushMetric(c)
+
+	for name, nodes := range i.nodes {
+		for _, node := range nodes {
+			for _, path := range node.Paths {
+				m.Push(metric_descriptions.ArangodbOperatorLocalStorageCapacityBytesGauge(float64(path.Capacity), name, node.NodeName, path.Path))
+				m.Push(metric_descriptions.ArangodbOperatorLocalStorageAvailableBytesGauge(float64(path.Available), name, node.NodeName, path.Path))
+				m.Push(metric_descriptions.ArangodbOperatorLocalStorageVolumesGauge(float64(path.Volumes), name, node.NodeName, path.Path))
+				m.Push(metric_descriptions.ArangodbOperatorLocalStorageBoundVolumesGauge(float64(path.Bound), name, node.NodeName, path.Path))
+				m.Push(metric_descriptions.ArangodbOperatorLocalStorageReleasedVolumesGauge(float64(path.Released), name, node.NodeName, path.Path))
+			}
+		}
+	}
+}
@@ -20,7 +20,11 @@
 
 package provisioner
 
-import "context"
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+)
 
 const (
 	DefaultPort = 8929
@@ -34,7 +38,7 @@ type API interface {
 	// the given local path on the current node.
 	GetInfo(ctx context.Context, localPath string) (Info, error)
 	// Prepare a volume at the given local path
-	Prepare(ctx context.Context, localPath string) error
+	Prepare(ctx context.Context, request PrepareRequest) (VolumeInfo, error)
 	// Remove a volume with the given local path
 	Remove(ctx context.Context, localPath string) error
 }
@@ -55,3 +59,20 @@ type Info struct {
 type Request struct {
 	LocalPath string `json:"localPath"`
 }
+
+// PrepareRequest body for Prepare HTTP requests.
+type PrepareRequest struct {
+	Request
+	// Size of the volume in bytes
+	Size int64 `json:"size,omitempty"`
+	// SizeEnforcement defines how size of the volume is enforced
+	SizeEnforcement api.LocalStorageSizeEnforcement `json:"sizeEnforcement,omitempty"`
+}
+
+// VolumeInfo holds information of a prepared volume.
+type VolumeInfo struct {
+	// EnforcedSize is the size limit (in bytes) enforced on the volume, 0 if size is not limited
+	EnforcedSize int64 `json:"enforcedSize,omitempty"`
+	// FSType is the filesystem to be created on the volume, empty if volume is a directory
+	FSType string `json:"fsType,omitempty"`
+}
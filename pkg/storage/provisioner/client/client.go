@@ -109,18 +109,16 @@ func (c *client) GetInfo(ctx context.Context, localPath string) (provisioner.Inf
 }
 
 // Prepare a volume at the given local path
-func (c *client) Prepare(ctx context.Context, localPath string) error {
-	input := provisioner.Request{
-		LocalPath: localPath,
-	}
-	req, err := c.newRequest("POST", "/prepare", input)
+func (c *client) Prepare(ctx context.Context, request provisioner.PrepareRequest) (provisioner.VolumeInfo, error) {
+	req, err := c.newRequest("POST", "/prepare", request)
 	if err != nil {
-		return errors.WithStack(err)
+		return provisioner.VolumeInfo{}, errors.WithStack(err)
 	}
-	if err := c.do(ctx, req, nil); err != nil {
-		return errors.WithStack(err)
+	var result provisioner.VolumeInfo
+	if err := c.do(ctx, req, &result); err != nil {
+		return provisioner.VolumeInfo{}, errors.WithStack(err)
 	}
-	return nil
+	return result, nil
 }
 
 // Remove a volume with the given local path
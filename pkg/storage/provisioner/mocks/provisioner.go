@@ -75,12 +75,15 @@ func (m *provisionerMock) GetInfo(ctx context.Context, localPath string) (provis
 }
 
 // Prepare a volume at the given local path
-func (m *provisionerMock) Prepare(ctx context.Context, localPath string) error {
-	if _, found := m.localPaths[localPath]; found {
-		return errors.Newf("Path already exists: %s", localPath)
+func (m *provisionerMock) Prepare(ctx context.Context, request provisioner.PrepareRequest) (provisioner.VolumeInfo, error) {
+	if _, found := m.localPaths[request.LocalPath]; found {
+		return provisioner.VolumeInfo{}, errors.Newf("Path already exists: %s", request.LocalPath)
 	}
-	m.localPaths[localPath] = struct{}{}
-	return nil
+	m.localPaths[request.LocalPath] = struct{}{}
+	if request.SizeEnforcement.IsEnforced() {
+		return provisioner.VolumeInfo{EnforcedSize: request.Size}, nil
+	}
+	return provisioner.VolumeInfo{}, nil
 }
 
 // Remove a volume with the given local path
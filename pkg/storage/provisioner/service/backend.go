@@ -0,0 +1,77 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package service
+
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+	"github.com/arangodb/kube-arangodb/pkg/logging"
+	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// BackendConfig defines the backend used by the provisioner
+type BackendConfig struct {
+	Type   api.LocalStorageBackendType // Type of the backend
+	FSType string                      // Filesystem created on the block volumes
+	LVM    api.LocalStorageLVMSpec     // Settings of the LVM backend
+}
+
+// Backend provisions the volumes on the node
+type Backend interface {
+	// GetInfo fetches capacity & available size of the storage used
+	// for volumes in the given local path.
+	GetInfo(ctx context.Context, localPath string) (provisioner.Info, error)
+	// Prepare a volume at the given local path
+	Prepare(ctx context.Context, request provisioner.PrepareRequest) (provisioner.VolumeInfo, error)
+	// Remove a volume with the given local path
+	Remove(ctx context.Context, localPath string) error
+}
+
+// backendRestorer is implemented by backends which have to restore
+// the volumes once the provisioner is started.
+type backendRestorer interface {
+	// Restore the volumes in the given local paths
+	Restore(ctx context.Context, localPaths []string) error
+}
+
+// newBackend creates the backend of the given type
+func newBackend(log logging.Logger, config BackendConfig) (Backend, error) {
+	fsType := config.FSType
+	if fsType == "" {
+		fsType = api.DefaultLocalStorageFSType
+	}
+
+	switch t := config.Type.Get(); t {
+	case api.LocalStorageBackendDirectory:
+		return newDirectoryBackend(log), nil
+	case api.LocalStorageBackendLoopFile:
+		return newLoopFileBackend(log, fsType), nil
+	case api.LocalStorageBackendLVM:
+		if err := config.LVM.Validate(); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return newLVMBackend(log, config.LVM, fsType, hostRootPath)
+	default:
+		return nil, errors.Newf("Unknown backend type %s", t)
+	}
+}
@@ -0,0 +1,133 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package service
+
+import (
+	"context"
+	"os"
+
+	"golang.org/x/sys/unix"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+	"github.com/arangodb/kube-arangodb/pkg/logging"
+	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// directoryBackend provisions volumes as directories in the local paths
+type directoryBackend struct {
+	log logging.Logger
+}
+
+func newDirectoryBackend(log logging.Logger) Backend {
+	return &directoryBackend{
+		log: log,
+	}
+}
+
+// GetInfo fetches information from the filesystem containing
+// the given local path.
+func (d *directoryBackend) GetInfo(ctx context.Context, localPath string) (provisioner.Info, error) {
+	return getFilesystemInfo(d.log, localPath)
+}
+
+// Prepare a directory at the given local path
+func (d *directoryBackend) Prepare(ctx context.Context, request provisioner.PrepareRequest) (provisioner.VolumeInfo, error) {
+	localPath := request.LocalPath
+	log := d.log.Str("local-path", localPath)
+	log.Debug("preparing local path")
+
+	// Make sure directory is empty
+	if err := os.RemoveAll(localPath); err != nil && !os.IsNotExist(err) {
+		log.Err(err).Error("Failed to clean existing directory")
+		return provisioner.VolumeInfo{}, errors.WithStack(err)
+	}
+	// Make sure directory exists
+	if err := os.MkdirAll(localPath, 0755); err != nil {
+		log.Err(err).Error("Failed to make directory")
+		return provisioner.VolumeInfo{}, errors.WithStack(err)
+	}
+	// Set access rights
+	if err := os.Chmod(localPath, 0777); err != nil {
+		log.Err(err).Error("Failed to set directory access")
+		return provisioner.VolumeInfo{}, errors.WithStack(err)
+	}
+
+	var info provisioner.VolumeInfo
+
+	switch request.SizeEnforcement.Get() {
+	case api.LocalStorageSizeEnforcementProjectQuota:
+		enforcedSize, err := setProjectQuota(localPath, request.Size)
+		if err != nil {
+			log.Err(err).Error("Failed to set project quota")
+			if err := os.RemoveAll(localPath); err != nil {
+				log.Err(err).Warn("Failed to clean directory")
+			}
+			return provisioner.VolumeInfo{}, errors.WithStack(err)
+		}
+		info.EnforcedSize = enforcedSize
+		log.Int64("enforced-size", enforcedSize).Debug("Project quota set")
+	}
+
+	return info, nil
+}
+
+// Remove a directory with the given local path
+func (d *directoryBackend) Remove(ctx context.Context, localPath string) error {
+	log := d.log.Str("local-path", localPath)
+	log.Debug("cleanup local path")
+
+	// Remove limit of the project quota
+	if err := clearProjectQuota(localPath); err != nil && !os.IsNotExist(errors.Cause(err)) {
+		log.Err(err).Debug("Failed to clear project quota")
+	}
+
+	// Make sure directory is empty
+	if err := os.RemoveAll(localPath); err != nil && !os.IsNotExist(err) {
+		log.Err(err).Error("Failed to clean directory")
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// getFilesystemInfo fetches capacity & available size of the filesystem
+// containing the given local path.
+func getFilesystemInfo(log logging.Logger, localPath string) (provisioner.Info, error) {
+	log = log.Str("local-path", localPath)
+
+	log.Debug("gettting info for local path")
+	statfs := &unix.Statfs_t{}
+	if err := unix.Statfs(localPath, statfs); err != nil {
+		log.Err(err).Error("Statfs failed")
+		return provisioner.Info{}, errors.WithStack(err)
+	}
+
+	// Available is blocks available * fragment size
+	available := int64(statfs.Bavail) * statfs.Bsize // nolint:typecheck
+
+	// Capacity is total block count * fragment size
+	capacity := int64(statfs.Blocks) * statfs.Bsize // nolint:typecheck
+
+	return provisioner.Info{
+		Available: available,
+		Capacity:  capacity,
+	}, nil
+}
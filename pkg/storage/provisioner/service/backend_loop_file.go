@@ -0,0 +1,270 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/arangodb/kube-arangodb/pkg/logging"
+	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// loopFileImageSuffix is the suffix of the image files backing the volumes
+	loopFileImageSuffix = ".img"
+	// loopControlDevice is the device used to allocate loop devices
+	loopControlDevice = "/dev/loop-control"
+	// loopAttachAttempts defines how many free loop devices are tried before attach fails
+	loopAttachAttempts = 16
+)
+
+// loopFileBackend provisions volumes as sparse image files in the local paths.
+// Image is attached to the loop device and the local path of the volume is
+// a symlink to the loop device, which is formatted by the kubelet.
+type loopFileBackend struct {
+	log    logging.Logger
+	fsType string
+}
+
+func newLoopFileBackend(log logging.Logger, fsType string) Backend {
+	return &loopFileBackend{
+		log:    log,
+		fsType: fsType,
+	}
+}
+
+// GetInfo fetches information from the filesystem containing
+// the given local path.
+func (l *loopFileBackend) GetInfo(ctx context.Context, localPath string) (provisioner.Info, error) {
+	return getFilesystemInfo(l.log, localPath)
+}
+
+// Prepare an image file attached to the loop device at the given local path
+func (l *loopFileBackend) Prepare(ctx context.Context, request provisioner.PrepareRequest) (provisioner.VolumeInfo, error) {
+	localPath := request.LocalPath
+	log := l.log.Str("local-path", localPath)
+	log.Debug("preparing loop device")
+
+	if request.Size <= 0 {
+		return provisioner.VolumeInfo{}, errors.Newf("Size of the volume is required by the loop device")
+	}
+
+	// Make sure nothing is left from the previous volume
+	if err := l.Remove(ctx, localPath); err != nil {
+		return provisioner.VolumeInfo{}, errors.WithStack(err)
+	}
+
+	image := localPath + loopFileImageSuffix
+
+	device, err := createLoopFile(image, request.Size)
+	if err != nil {
+		log.Err(err).Error("Failed to create loop device")
+		if err := os.Remove(image); err != nil && !os.IsNotExist(err) {
+			log.Err(err).Warn("Failed to remove image")
+		}
+		return provisioner.VolumeInfo{}, errors.WithStack(err)
+	}
+
+	if err := os.Symlink(device, localPath); err != nil {
+		log.Err(err).Error("Failed to link loop device")
+		if err := l.Remove(ctx, localPath); err != nil {
+			log.Err(err).Warn("Failed to remove loop device")
+		}
+		return provisioner.VolumeInfo{}, errors.WithStack(err)
+	}
+
+	log.Str("device", device).Debug("Loop device attached")
+
+	return provisioner.VolumeInfo{
+		EnforcedSize: request.Size,
+		FSType:       l.fsType,
+	}, nil
+}
+
+// Remove the loop device and image file with the given local path
+func (l *loopFileBackend) Remove(ctx context.Context, localPath string) error {
+	log := l.log.Str("local-path", localPath)
+	log.Debug("cleanup loop device")
+
+	image := localPath + loopFileImageSuffix
+
+	// Detach loop device if local path is still linked to it
+	if device, err := os.Readlink(localPath); err == nil && isLoopDeviceBackedBy(device, image) {
+		if err := detachLoopDevice(device); err != nil {
+			log.Err(err).Str("device", device).Error("Failed to detach loop device")
+			return errors.WithStack(err)
+		}
+	}
+
+	for _, p := range []string{localPath, image} {
+		if err := os.RemoveAll(p); err != nil && !os.IsNotExist(err) {
+			log.Err(err).Error("Failed to clean local path")
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+// Restore attaches the images in the given local paths which are
+// no longer attached, e.g. after reboot of the node.
+func (l *loopFileBackend) Restore(ctx context.Context, localPaths []string) error {
+	for _, localPathRoot := range localPaths {
+		images, err := filepath.Glob(filepath.Join(localPathRoot, "*"+loopFileImageSuffix))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		for _, image := range images {
+			localPath := strings.TrimSuffix(image, loopFileImageSuffix)
+			log := l.log.Str("local-path", localPath)
+
+			if device, err := os.Readlink(localPath); err == nil && isLoopDeviceBackedBy(device, image) {
+				continue
+			}
+
+			device, err := attachLoopFile(image)
+			if err != nil {
+				log.Err(err).Error("Failed to attach loop device")
+				continue
+			}
+
+			if err := os.Remove(localPath); err != nil && !os.IsNotExist(err) {
+				log.Err(err).Error("Failed to remove stale link")
+				continue
+			}
+
+			if err := os.Symlink(device, localPath); err != nil {
+				log.Err(err).Error("Failed to link loop device")
+				continue
+			}
+
+			log.Str("device", device).Info("Loop device restored")
+		}
+	}
+
+	return nil
+}
+
+// createLoopFile creates sparse image of the given size and attaches it to the loop device.
+func createLoopFile(image string, size int64) (string, error) {
+	f, err := os.OpenFile(image, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	defer f.Close()
+
+	if err := f.Truncate(size); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return attachLoopDevice(f)
+}
+
+// attachLoopFile attaches the existing image to the loop device.
+func attachLoopFile(image string) (string, error) {
+	f, err := os.OpenFile(image, os.O_RDWR, 0)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	defer f.Close()
+
+	return attachLoopDevice(f)
+}
+
+// attachLoopDevice attaches the given file to the free loop device.
+// Returns path of the loop device.
+func attachLoopDevice(f *os.File) (string, error) {
+	ctl, err := os.OpenFile(loopControlDevice, os.O_RDWR, 0)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	defer ctl.Close()
+
+	for i := 0; i < loopAttachAttempts; i++ {
+		id, err := unix.IoctlRetInt(int(ctl.Fd()), unix.LOOP_CTL_GET_FREE)
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+
+		device := fmt.Sprintf("/dev/loop%d", id)
+
+		dev, err := os.OpenFile(device, os.O_RDWR, 0)
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+
+		err = unix.IoctlSetInt(int(dev.Fd()), unix.LOOP_SET_FD, int(f.Fd()))
+		dev.Close()
+
+		if err == nil {
+			return device, nil
+		}
+
+		if err != unix.EBUSY {
+			return "", errors.WithStack(err)
+		}
+
+		// Device was taken in the meantime, try next one
+	}
+
+	return "", errors.Newf("Unable to find free loop device")
+}
+
+// detachLoopDevice detaches the file from the given loop device.
+// If device is still in use, it is detached once it is closed.
+func detachLoopDevice(device string) error {
+	dev, err := os.OpenFile(device, os.O_RDONLY, 0)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer dev.Close()
+
+	if err := unix.IoctlSetInt(int(dev.Fd()), unix.LOOP_CLR_FD, 0); err != nil && err != unix.ENXIO {
+		// ENXIO is returned if device is not attached
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// isLoopDeviceBackedBy returns true if the given loop device is attached to the image
+func isLoopDeviceBackedBy(device, image string) bool {
+	data, err := ioutil.ReadFile(loopBackingFilePath(device))
+	if err != nil {
+		return false
+	}
+
+	return strings.TrimSpace(string(data)) == image
+}
+
+// loopBackingFilePath returns path of the sysfs file containing backing file of the loop device
+func loopBackingFilePath(device string) string {
+	return filepath.Join("/sys/block", filepath.Base(device), "loop", "backing_file")
+}
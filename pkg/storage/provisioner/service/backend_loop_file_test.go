@@ -0,0 +1,91 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
+)
+
+func Test_LoopFileBackend(t *testing.T) {
+	if _, err := os.Stat(loopControlDevice); err != nil || os.Geteuid() != 0 {
+		t.Skip("Loop devices are not available")
+	}
+
+	ctx := context.Background()
+	root := t.TempDir()
+	localPath := filepath.Join(root, "volume")
+	image := localPath + loopFileImageSuffix
+
+	b := newLoopFileBackend(logger, "ext4")
+
+	info, err := b.Prepare(ctx, provisioner.PrepareRequest{
+		Request: provisioner.Request{
+			LocalPath: localPath,
+		},
+		Size: 64 * 1024 * 1024,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(64*1024*1024), info.EnforcedSize)
+	require.Equal(t, "ext4", info.FSType)
+
+	device, err := os.Readlink(localPath)
+	require.NoError(t, err)
+	require.True(t, isLoopDeviceBackedBy(device, image))
+
+	// Device is attached again once detached
+	require.NoError(t, detachLoopDevice(device))
+	require.False(t, isLoopDeviceBackedBy(device, image))
+	require.NoError(t, b.(backendRestorer).Restore(ctx, []string{root}))
+
+	device, err = os.Readlink(localPath)
+	require.NoError(t, err)
+	require.True(t, isLoopDeviceBackedBy(device, image))
+
+	require.NoError(t, b.Remove(ctx, localPath))
+	require.False(t, isLoopDeviceBackedBy(device, image))
+
+	_, err = os.Lstat(localPath)
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(image)
+	require.True(t, os.IsNotExist(err))
+}
+
+func Test_LoopFileBackend_RequiresSize(t *testing.T) {
+	b := newLoopFileBackend(logger, "ext4")
+
+	_, err := b.Prepare(context.Background(), provisioner.PrepareRequest{
+		Request: provisioner.Request{
+			LocalPath: filepath.Join(t.TempDir(), "volume"),
+		},
+	})
+	require.Error(t, err)
+}
+
+func Test_LoopBackingFilePath(t *testing.T) {
+	require.Equal(t, "/sys/block/loop3/loop/backing_file", loopBackingFilePath("/dev/loop3"))
+}
@@ -0,0 +1,292 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+	"github.com/arangodb/kube-arangodb/pkg/logging"
+	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// hostRootPath is the path in which root filesystem of the node is mounted
+	hostRootPath = "/host"
+	// lvmVolumePrefix is the prefix of logical volumes created by the provisioner
+	lvmVolumePrefix = "arangodb-"
+)
+
+// lvmBinaryPaths is the list of paths in which lvm binary is searched on the node
+var lvmBinaryPaths = []string{"/usr/sbin/lvm", "/sbin/lvm", "/usr/bin/lvm", "/bin/lvm"}
+
+// commandRunner executes the command and returns its standard output
+type commandRunner func(ctx context.Context, name string, args ...string) ([]byte, error)
+
+// newHostCommandRunner returns runner executing commands in the root filesystem of the node
+func newHostCommandRunner(hostRoot string) commandRunner {
+	return func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		var stdout, stderr bytes.Buffer
+
+		cmd := exec.CommandContext(ctx, name, args...)
+		cmd.Env = []string{"LC_ALL=C", "PATH=/usr/sbin:/usr/bin:/sbin:/bin", "LVM_SUPPRESS_FD_WARNINGS=1"}
+		cmd.Dir = "/"
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		cmd.SysProcAttr = &syscall.SysProcAttr{
+			Chroot: hostRoot,
+		}
+
+		if err := cmd.Run(); err != nil {
+			return nil, errors.Wrapf(err, "Command %s %s failed: %s", name, strings.Join(args, " "), strings.TrimSpace(stderr.String()))
+		}
+
+		return stdout.Bytes(), nil
+	}
+}
+
+// lvmBackend provisions volumes as thin logical volumes in the LVM thin pool.
+// The local path of the volume is a symlink to the logical volume, which is formatted by the kubelet.
+type lvmBackend struct {
+	log    logging.Logger
+	spec   api.LocalStorageLVMSpec
+	fsType string
+
+	lvm string
+	run commandRunner
+}
+
+func newLVMBackend(log logging.Logger, spec api.LocalStorageLVMSpec, fsType, hostRoot string) (Backend, error) {
+	var lvm string
+	for _, p := range lvmBinaryPaths {
+		if _, err := os.Stat(filepath.Join(hostRoot, p)); err == nil {
+			lvm = p
+			break
+		}
+	}
+
+	if lvm == "" {
+		return nil, errors.Newf("Unable to find lvm binary in %s", hostRoot)
+	}
+
+	return &lvmBackend{
+		log:    log,
+		spec:   spec,
+		fsType: fsType,
+		lvm:    lvm,
+		run:    newHostCommandRunner(hostRoot),
+	}, nil
+}
+
+// GetInfo fetches size & free space of the thin pool
+func (l *lvmBackend) GetInfo(ctx context.Context, localPath string) (provisioner.Info, error) {
+	out, err := l.run(ctx, l.lvm, "lvs", "--noheadings", "--nosuffix", "--units", "b", "--options", "lv_size,data_percent", l.poolName())
+	if err != nil {
+		l.log.Err(err).Str("thin-pool", l.poolName()).Error("Failed to get thin pool info")
+		return provisioner.Info{}, errors.WithStack(err)
+	}
+
+	capacity, available, err := parseLVMThinPoolInfo(out)
+	if err != nil {
+		return provisioner.Info{}, errors.WithStack(err)
+	}
+
+	return provisioner.Info{
+		Available: available,
+		Capacity:  capacity,
+	}, nil
+}
+
+// Prepare a thin logical volume linked to the given local path
+func (l *lvmBackend) Prepare(ctx context.Context, request provisioner.PrepareRequest) (provisioner.VolumeInfo, error) {
+	localPath := request.LocalPath
+	name := lvmVolumeName(localPath)
+	log := l.log.Str("local-path", localPath).Str("logical-volume", name)
+	log.Debug("preparing logical volume")
+
+	if request.Size <= 0 {
+		return provisioner.VolumeInfo{}, errors.Newf("Size of the volume is required by the logical volume")
+	}
+
+	// Make sure nothing is left from the previous volume
+	if err := l.Remove(ctx, localPath); err != nil {
+		return provisioner.VolumeInfo{}, errors.WithStack(err)
+	}
+
+	if _, err := l.run(ctx, l.lvm, "lvcreate", "--yes", "--type", "thin", "--virtualsize", strconv.FormatInt(request.Size, 10)+"b",
+		"--thinpool", l.spec.ThinPool, "--name", name, l.spec.VolumeGroup); err != nil {
+		log.Err(err).Error("Failed to create logical volume")
+		return provisioner.VolumeInfo{}, errors.WithStack(err)
+	}
+
+	size, err := l.getVolumeSize(ctx, name)
+	if err != nil {
+		log.Err(err).Error("Failed to get size of logical volume")
+		if err := l.removeVolume(ctx, name); err != nil {
+			log.Err(err).Warn("Failed to remove logical volume")
+		}
+		return provisioner.VolumeInfo{}, errors.WithStack(err)
+	}
+
+	if err := os.Symlink(l.devicePath(name), localPath); err != nil {
+		log.Err(err).Error("Failed to link logical volume")
+		if err := l.removeVolume(ctx, name); err != nil {
+			log.Err(err).Warn("Failed to remove logical volume")
+		}
+		return provisioner.VolumeInfo{}, errors.WithStack(err)
+	}
+
+	log.Int64("size", size).Debug("Logical volume created")
+
+	return provisioner.VolumeInfo{
+		EnforcedSize: size,
+		FSType:       l.fsType,
+	}, nil
+}
+
+// Remove the logical volume linked to the given local path
+func (l *lvmBackend) Remove(ctx context.Context, localPath string) error {
+	log := l.log.Str("local-path", localPath)
+	log.Debug("cleanup logical volume")
+
+	// Remove logical volume if local path is still linked to it
+	if device, err := os.Readlink(localPath); err == nil {
+		if name, ok := l.parseDevicePath(device); ok {
+			if err := l.removeVolume(ctx, name); err != nil {
+				log.Err(err).Str("logical-volume", name).Error("Failed to remove logical volume")
+				return errors.WithStack(err)
+			}
+		}
+	}
+
+	if err := os.RemoveAll(localPath); err != nil && !os.IsNotExist(err) {
+		log.Err(err).Error("Failed to clean local path")
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// removeVolume removes the logical volume if it exists
+func (l *lvmBackend) removeVolume(ctx context.Context, name string) error {
+	out, err := l.run(ctx, l.lvm, "lvs", "--noheadings", "--options", "lv_name", l.spec.VolumeGroup)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	found := false
+	for _, line := range strings.Split(string(out), "\n") {
+		if strings.TrimSpace(line) == name {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	if _, err := l.run(ctx, l.lvm, "lvremove", "--yes", l.volumeName(name)); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// getVolumeSize returns size of the logical volume in bytes
+func (l *lvmBackend) getVolumeSize(ctx context.Context, name string) (int64, error) {
+	out, err := l.run(ctx, l.lvm, "lvs", "--noheadings", "--nosuffix", "--units", "b", "--options", "lv_size", l.volumeName(name))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	size, err := strconv.ParseInt(strings.TrimSpace(string(out)), 10, 64)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return size, nil
+}
+
+// poolName returns full name of the thin pool
+func (l *lvmBackend) poolName() string {
+	return l.volumeName(l.spec.ThinPool)
+}
+
+// volumeName returns full name of the logical volume
+func (l *lvmBackend) volumeName(name string) string {
+	return l.spec.VolumeGroup + "/" + name
+}
+
+// devicePath returns path of the logical volume device
+func (l *lvmBackend) devicePath(name string) string {
+	return filepath.Join("/dev", l.spec.VolumeGroup, name)
+}
+
+// parseDevicePath returns name of the logical volume created by the provisioner
+func (l *lvmBackend) parseDevicePath(device string) (string, bool) {
+	name := filepath.Base(device)
+
+	if filepath.Dir(device) != filepath.Join("/dev", l.spec.VolumeGroup) || !strings.HasPrefix(name, lvmVolumePrefix) {
+		return "", false
+	}
+
+	return name, true
+}
+
+// lvmVolumeName returns name of the logical volume for the given local path
+func lvmVolumeName(localPath string) string {
+	return lvmVolumePrefix + filepath.Base(localPath)
+}
+
+// parseLVMThinPoolInfo returns size & free space (in bytes) of the thin pool
+// from the `lvs -o lv_size,data_percent` output.
+func parseLVMThinPoolInfo(out []byte) (int64, int64, error) {
+	fields := strings.Fields(string(out))
+	if len(fields) != 2 {
+		return 0, 0, errors.Newf("Unexpected thin pool info: %s", strings.TrimSpace(string(out)))
+	}
+
+	size, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return 0, 0, errors.WithStack(err)
+	}
+
+	usedPercent, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return 0, 0, errors.WithStack(err)
+	}
+
+	used := int64(float64(size) * usedPercent / 100)
+	if used > size {
+		used = size
+	}
+
+	return size, size - used, nil
+}
@@ -0,0 +1,130 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
+)
+
+// fakeLVM records lvm commands and returns predefined outputs
+type fakeLVM struct {
+	commands []string
+	volumes  []string
+}
+
+func (f *fakeLVM) run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	cmd := strings.Join(args, " ")
+	f.commands = append(f.commands, cmd)
+
+	switch {
+	case strings.HasPrefix(cmd, "lvcreate"):
+		f.volumes = append(f.volumes, args[len(args)-2])
+	case strings.HasPrefix(cmd, "lvremove"):
+		f.volumes = nil
+	case strings.HasSuffix(cmd, "lv_size,data_percent vg/pool"):
+		return []byte("  107374182400 25.00\n"), nil
+	case strings.HasSuffix(cmd, "lv_size vg/"+lvmVolumeName("volume")):
+		return []byte("  1077936128\n"), nil
+	case strings.HasSuffix(cmd, "lv_name vg"):
+		return []byte("  pool\n  " + strings.Join(f.volumes, "\n  ") + "\n"), nil
+	}
+
+	return nil, nil
+}
+
+func newFakeLVMBackend() (*lvmBackend, *fakeLVM) {
+	f := &fakeLVM{}
+
+	return &lvmBackend{
+		log:    logger,
+		spec:   api.LocalStorageLVMSpec{VolumeGroup: "vg", ThinPool: "pool"},
+		fsType: "xfs",
+		lvm:    "/sbin/lvm",
+		run:    f.run,
+	}, f
+}
+
+func Test_LVMBackend(t *testing.T) {
+	ctx := context.Background()
+	localPath := filepath.Join(t.TempDir(), "volume")
+	name := lvmVolumeName(localPath)
+
+	b, f := newFakeLVMBackend()
+
+	info, err := b.GetInfo(ctx, filepath.Dir(localPath))
+	require.NoError(t, err)
+	require.Equal(t, int64(107374182400), info.Capacity)
+	require.Equal(t, int64(80530636800), info.Available)
+
+	volume, err := b.Prepare(ctx, provisioner.PrepareRequest{
+		Request: provisioner.Request{
+			LocalPath: localPath,
+		},
+		Size: 1024 * 1024 * 1024,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1077936128), volume.EnforcedSize)
+	require.Equal(t, "xfs", volume.FSType)
+	require.Contains(t, f.commands, "lvcreate --yes --type thin --virtualsize 1073741824b --thinpool pool --name "+name+" vg")
+
+	device, err := os.Readlink(localPath)
+	require.NoError(t, err)
+	require.Equal(t, "/dev/vg/"+name, device)
+
+	require.NoError(t, b.Remove(ctx, localPath))
+	require.Contains(t, f.commands, "lvremove --yes vg/"+name)
+
+	_, err = os.Lstat(localPath)
+	require.True(t, os.IsNotExist(err))
+}
+
+func Test_LVMBackend_ParseDevicePath(t *testing.T) {
+	b, _ := newFakeLVMBackend()
+
+	name, ok := b.parseDevicePath("/dev/vg/arangodb-abc")
+	require.True(t, ok)
+	require.Equal(t, "arangodb-abc", name)
+
+	_, ok = b.parseDevicePath("/dev/vg/pool")
+	require.False(t, ok)
+
+	_, ok = b.parseDevicePath("/dev/other/arangodb-abc")
+	require.False(t, ok)
+}
+
+func Test_ParseLVMThinPoolInfo(t *testing.T) {
+	size, available, err := parseLVMThinPoolInfo([]byte("  1000 12.50\n"))
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), size)
+	require.Equal(t, int64(875), available)
+
+	_, _, err = parseLVMThinPoolInfo([]byte("  1000\n"))
+	require.Error(t, err)
+}
@@ -22,10 +22,8 @@ package service
 
 import (
 	"context"
-	"os"
 
 	"github.com/rs/zerolog"
-	"golang.org/x/sys/unix"
 
 	"github.com/arangodb/kube-arangodb/pkg/logging"
 	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
@@ -36,14 +34,18 @@ var logger = logging.Global().RegisterAndGetLogger("deployment-storage-service",
 
 // Config for the storage provisioner
 type Config struct {
-	Address  string // Server address to listen on
-	NodeName string // Name of the run I'm running now
+	Address    string        // Server address to listen on
+	NodeName   string        // Name of the run I'm running now
+	LocalPaths []string      // Local paths in which volumes are provisioned
+	Backend    BackendConfig // Backend used to provision volumes
 }
 
 // Provisioner implements a Local storage provisioner
 type Provisioner struct {
 	Log logging.Logger
 	Config
+
+	backend Backend
 }
 
 // New creates a new local storage provisioner
@@ -54,6 +56,12 @@ func New(config Config) (*Provisioner, error) {
 
 	p.Log = logger.WrapObj(p)
 
+	backend, err := newBackend(p.Log, config.Backend)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	p.backend = backend
+
 	return p, nil
 }
 
@@ -63,6 +71,12 @@ func (p *Provisioner) WrapLogger(in *zerolog.Event) *zerolog.Event {
 
 // Run the provisioner until the given context is canceled.
 func (p *Provisioner) Run(ctx context.Context) {
+	if r, ok := p.backend.(backendRestorer); ok {
+		if err := r.Restore(ctx, p.LocalPaths); err != nil {
+			p.Log.Err(err).Error("Failed to restore volumes")
+		}
+	}
+
 	runServer(ctx, p.Log, p.Address, p)
 }
 
@@ -73,70 +87,33 @@ func (p *Provisioner) GetNodeInfo(ctx context.Context) (provisioner.NodeInfo, er
 	}, nil
 }
 
-// GetInfo fetches information from the filesystem containing
-// the given local path.
+// GetInfo fetches information from the storage used for
+// volumes in the given local path.
 func (p *Provisioner) GetInfo(ctx context.Context, localPath string) (provisioner.Info, error) {
-	log := p.Log.Str("local-path", localPath)
-
-	log.Debug("gettting info for local path")
-	statfs := &unix.Statfs_t{}
-	if err := unix.Statfs(localPath, statfs); err != nil {
-		log.Err(err).Error("Statfs failed")
+	info, err := p.backend.GetInfo(ctx, localPath)
+	if err != nil {
 		return provisioner.Info{}, errors.WithStack(err)
 	}
 
-	// Available is blocks available * fragment size
-	available := int64(statfs.Bavail) * statfs.Bsize // nolint:typecheck
-
-	// Capacity is total block count * fragment size
-	capacity := int64(statfs.Blocks) * statfs.Bsize // nolint:typecheck
+	info.NodeInfo = provisioner.NodeInfo{
+		NodeName: p.NodeName,
+	}
 
-	log.
+	p.Log.
+		Str("local-path", localPath).
 		Str("node-name", p.NodeName).
-		Int64("capacity", capacity).
-		Int64("available", available).
+		Int64("capacity", info.Capacity).
+		Int64("available", info.Available).
 		Debug("Returning info for local path")
-	return provisioner.Info{
-		NodeInfo: provisioner.NodeInfo{
-			NodeName: p.NodeName,
-		},
-		Available: available,
-		Capacity:  capacity,
-	}, nil
+	return info, nil
 }
 
 // Prepare a volume at the given local path
-func (p *Provisioner) Prepare(ctx context.Context, localPath string) error {
-	log := p.Log.Str("local-path", localPath)
-	log.Debug("preparing local path")
-
-	// Make sure directory is empty
-	if err := os.RemoveAll(localPath); err != nil && !os.IsNotExist(err) {
-		log.Err(err).Error("Failed to clean existing directory")
-		return errors.WithStack(err)
-	}
-	// Make sure directory exists
-	if err := os.MkdirAll(localPath, 0755); err != nil {
-		log.Err(err).Error("Failed to make directory")
-		return errors.WithStack(err)
-	}
-	// Set access rights
-	if err := os.Chmod(localPath, 0777); err != nil {
-		log.Err(err).Error("Failed to set directory access")
-		return errors.WithStack(err)
-	}
-	return nil
+func (p *Provisioner) Prepare(ctx context.Context, request provisioner.PrepareRequest) (provisioner.VolumeInfo, error) {
+	return p.backend.Prepare(ctx, request)
 }
 
 // Remove a volume with the given local path
 func (p *Provisioner) Remove(ctx context.Context, localPath string) error {
-	log := p.Log.Str("local-path", localPath)
-	log.Debug("cleanup local path")
-
-	// Make sure directory is empty
-	if err := os.RemoveAll(localPath); err != nil && !os.IsNotExist(err) {
-		log.Err(err).Error("Failed to clean directory")
-		return errors.WithStack(err)
-	}
-	return nil
+	return p.backend.Remove(ctx, localPath)
 }
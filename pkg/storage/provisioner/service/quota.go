@@ -0,0 +1,296 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package service
+
+import (
+	"bufio"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// Constants and structures below are not exposed by golang.org/x/sys/unix.
+const (
+	// fsIocFsGetXAttr is FS_IOC_FSGETXATTR from linux/fs.h
+	fsIocFsGetXAttr = 0x801c581f
+	// fsIocFsSetXAttr is FS_IOC_FSSETXATTR from linux/fs.h
+	fsIocFsSetXAttr = 0x401c5820
+	// fsXFlagProjInherit is FS_XFLAG_PROJINHERIT from linux/fs.h
+	fsXFlagProjInherit = 0x00000200
+
+	// qGetQuota is Q_GETQUOTA from linux/quota.h
+	qGetQuota = 0x800007
+	// qSetQuota is Q_SETQUOTA from linux/quota.h
+	qSetQuota = 0x800008
+	// prjQuota is PRJQUOTA from linux/quota.h
+	prjQuota = 2
+	// qifBLimits is QIF_BLIMITS from linux/quota.h
+	qifBLimits = 1
+	// quotaBlockSize is QIF_DQBLKSIZE from linux/quota.h
+	quotaBlockSize = 1024
+
+	// projectIDMin is the lowest project ID assigned to the volumes
+	projectIDMin = 1 << 20
+	// projectIDAttempts defines how many project IDs are tried before preparation fails
+	projectIDAttempts = 128
+)
+
+// fsxattr is struct fsxattr from linux/fs.h
+type fsxattr struct {
+	XFlags     uint32
+	ExtSize    uint32
+	NextEnts   uint32
+	ProjID     uint32
+	CowExtSize uint32
+	Pad        [8]byte
+}
+
+// ifDqblk is struct if_dqblk from linux/quota.h
+type ifDqblk struct {
+	BHardLimit uint64
+	BSoftLimit uint64
+	CurSpace   uint64
+	IHardLimit uint64
+	ISoftLimit uint64
+	CurInodes  uint64
+	BTime      uint64
+	ITime      uint64
+	Valid      uint32
+}
+
+// quotaCmd returns quotactl command for project quotas
+func quotaCmd(cmd int) int {
+	return cmd<<8 | prjQuota
+}
+
+func quotactl(cmd int, device string, id uint32, dq *ifDqblk) error {
+	dev, err := unix.BytePtrFromString(device)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, _, errno := unix.Syscall6(unix.SYS_QUOTACTL, uintptr(quotaCmd(cmd)), uintptr(unsafe.Pointer(dev)), uintptr(id), uintptr(unsafe.Pointer(dq)), 0, 0); errno != 0 {
+		return errors.WithStack(errno)
+	}
+
+	return nil
+}
+
+func getFsxattr(path string) (fsxattr, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return fsxattr{}, errors.WithStack(err)
+	}
+	defer f.Close()
+
+	var attr fsxattr
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fsIocFsGetXAttr, uintptr(unsafe.Pointer(&attr))); errno != 0 {
+		return fsxattr{}, errors.WithStack(errno)
+	}
+
+	return attr, nil
+}
+
+func setFsxattr(path string, attr fsxattr) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer f.Close()
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fsIocFsSetXAttr, uintptr(unsafe.Pointer(&attr))); errno != 0 {
+		return errors.WithStack(errno)
+	}
+
+	return nil
+}
+
+// getMountDevice returns the device of the filesystem containing the given path
+func getMountDevice(path string) (string, error) {
+	f, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	defer f.Close()
+
+	var mountPoint, device string
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		point, source, ok := parseMountInfoLine(scanner.Text())
+		if !ok || !isPathWithin(path, point) {
+			continue
+		}
+
+		if len(point) >= len(mountPoint) {
+			mountPoint, device = point, source
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	if device == "" {
+		return "", errors.Newf("Unable to find mount of %s", path)
+	}
+
+	return device, nil
+}
+
+// parseMountInfoLine returns mount point and mount source from the line of /proc/self/mountinfo
+func parseMountInfoLine(line string) (string, string, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 5 {
+		return "", "", false
+	}
+
+	for i := 5; i < len(fields); i++ {
+		if fields[i] == "-" {
+			if i+2 >= len(fields) {
+				return "", "", false
+			}
+
+			return unescapeMountInfo(fields[4]), unescapeMountInfo(fields[i+2]), true
+		}
+	}
+
+	return "", "", false
+}
+
+// unescapeMountInfo replaces octal escapes used in /proc/self/mountinfo
+func unescapeMountInfo(s string) string {
+	return strings.NewReplacer(`\040`, " ", `\011`, "\t", `\012`, "\n", `\134`, `\`).Replace(s)
+}
+
+func isPathWithin(path, root string) bool {
+	if root == "/" {
+		return true
+	}
+
+	return path == root || strings.HasPrefix(path, root+"/")
+}
+
+// projectID returns the preferred project ID for the volume
+func projectID(localPath string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(filepath.Base(localPath)))
+	return projectIDMin + h.Sum32()%(1<<31-projectIDMin)
+}
+
+// setProjectQuota assigns a free project to the given directory and limits its size.
+// Returns the enforced limit in bytes.
+func setProjectQuota(localPath string, size int64) (int64, error) {
+	device, err := getMountDevice(localPath)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	id := projectID(localPath)
+	found := false
+
+	for i := 0; i < projectIDAttempts; i++ {
+		var dq ifDqblk
+		if err := quotactl(qGetQuota, device, id, &dq); err != nil {
+			if errors.Cause(err) != unix.ENOENT {
+				// ESRCH is returned if project quotas are not enabled
+				return 0, errors.Wrapf(err, "Project quotas are not supported on %s", device)
+			}
+		} else if dq.BHardLimit != 0 || dq.CurSpace != 0 {
+			// Project is in use
+			id++
+			continue
+		}
+
+		found = true
+		break
+	}
+
+	if !found {
+		return 0, errors.Newf("Unable to find free project ID on %s", device)
+	}
+
+	attr, err := getFsxattr(localPath)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	attr.ProjID = id
+	attr.XFlags |= fsXFlagProjInherit
+
+	if err := setFsxattr(localPath, attr); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	blocks := uint64((size + quotaBlockSize - 1) / quotaBlockSize)
+
+	dq := ifDqblk{
+		BHardLimit: blocks,
+		BSoftLimit: blocks,
+		Valid:      qifBLimits,
+	}
+
+	if err := quotactl(qSetQuota, device, id, &dq); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return int64(blocks) * quotaBlockSize, nil
+}
+
+// clearProjectQuota removes the limit of the project assigned to the given directory.
+// Nothing is done if directory is in the same project as its parent.
+func clearProjectQuota(localPath string) error {
+	attr, err := getFsxattr(localPath)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if attr.ProjID == 0 {
+		return nil
+	}
+
+	parent, err := getFsxattr(filepath.Dir(localPath))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if parent.ProjID == attr.ProjID {
+		// Project is not owned by the volume
+		return nil
+	}
+
+	device, err := getMountDevice(localPath)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	dq := ifDqblk{
+		Valid: qifBLimits,
+	}
+
+	return quotactl(qSetQuota, device, attr.ProjID, &dq)
+}
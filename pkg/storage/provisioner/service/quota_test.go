@@ -0,0 +1,58 @@
+//
+// DISCLAIMER
+//
+// Copyright 2016-2022 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseMountInfoLine(t *testing.T) {
+	point, source, ok := parseMountInfoLine("36 35 98:0 /mnt1 /var/lib/arango\\040storage rw,noatime master:1 - xfs /dev/sdb1 rw,prjquota")
+	require.True(t, ok)
+	require.Equal(t, "/var/lib/arango storage", point)
+	require.Equal(t, "/dev/sdb1", source)
+
+	point, source, ok = parseMountInfoLine("22 1 8:1 / / rw,relatime - ext4 /dev/sda1 rw")
+	require.True(t, ok)
+	require.Equal(t, "/", point)
+	require.Equal(t, "/dev/sda1", source)
+
+	_, _, ok = parseMountInfoLine("22 1 8:1 / / rw,relatime")
+	require.False(t, ok)
+}
+
+func Test_IsPathWithin(t *testing.T) {
+	require.True(t, isPathWithin("/data/abc", "/"))
+	require.True(t, isPathWithin("/data/abc", "/data"))
+	require.True(t, isPathWithin("/data", "/data"))
+	require.False(t, isPathWithin("/database/abc", "/data"))
+}
+
+func Test_ProjectID(t *testing.T) {
+	id := projectID("/data/abc")
+
+	require.Equal(t, id, projectID("/other/abc"))
+	require.NotEqual(t, id, projectID("/data/def"))
+	require.GreaterOrEqual(t, id, uint32(projectIDMin))
+	require.Less(t, id, uint32(1<<31))
+}
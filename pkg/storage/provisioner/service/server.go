@@ -102,14 +102,15 @@ func getInfoHandler(api provisioner.API) func(w http.ResponseWriter, r *http.Req
 func getPrepareHandler(api provisioner.API) func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		ctx := r.Context()
-		var input provisioner.Request
+		var input provisioner.PrepareRequest
 		if err := parseBody(r, &input); err != nil {
 			handleError(w, err)
 		} else {
-			if err := api.Prepare(ctx, input.LocalPath); err != nil {
+			result, err := api.Prepare(ctx, input)
+			if err != nil {
 				handleError(w, err)
 			} else {
-				sendJSON(w, struct{}{})
+				sendJSON(w, result)
 			}
 		}
 	}
@@ -39,6 +39,7 @@ import (
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
 	"github.com/arangodb/kube-arangodb/pkg/storage/provisioner"
+	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/constants"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
@@ -54,6 +55,8 @@ const (
 var (
 	// name of the annotation containing the node name
 	nodeNameAnnotation = api.SchemeGroupVersion.Group + "/node-name"
+	// name of the annotation containing the size limit (in bytes) enforced on the volume
+	enforcedSizeAnnotation = api.SchemeGroupVersion.Group + "/enforced-size"
 )
 
 // createPVs creates a given number of PersistentVolume's.
@@ -133,7 +136,14 @@ func (ls *LocalStorage) createPV(ctx context.Context, apiObject *api.ArangoLocal
 			name := strings.ToLower(uniuri.New())
 			localPath := filepath.Join(localPathRoot, name)
 			log = ls.log.Str("local-path", localPath)
-			if err := client.Prepare(ctx, localPath); err != nil {
+			volumeInfo, err := client.Prepare(ctx, provisioner.PrepareRequest{
+				Request: provisioner.Request{
+					LocalPath: localPath,
+				},
+				Size:            volSize,
+				SizeEnforcement: apiObject.Spec.SizeEnforcement.Get(),
+			})
+			if err != nil {
 				log.Err(err).Error("Failed to prepare local path")
 				continue
 			}
@@ -180,6 +190,13 @@ func (ls *LocalStorage) createPV(ctx context.Context, apiObject *api.ArangoLocal
 					},
 				},
 			}
+			if volumeInfo.EnforcedSize > 0 {
+				pv.Annotations[enforcedSizeAnnotation] = strconv.FormatInt(volumeInfo.EnforcedSize, 10)
+			}
+			if volumeInfo.FSType != "" {
+				// Volume is a block device which needs to be formatted by the kubelet
+				pv.Spec.Local.FSType = util.NewString(volumeInfo.FSType)
+			}
 			// Attach PV to ArangoLocalStorage
 			pv.SetOwnerReferences(append(pv.GetOwnerReferences(), apiObject.AsOwner()))
 			if _, err := ls.deps.Client.Kubernetes().CoreV1().PersistentVolumes().Create(context.Background(), pv, meta.CreateOptions{}); err != nil {
@@ -189,6 +206,7 @@ func (ls *LocalStorage) createPV(ctx context.Context, apiObject *api.ArangoLocal
 			log.
 				Str("name", pvName).
 				Str("node-name", info.NodeName).
+				Int64("enforced-size", volumeInfo.EnforcedSize).
 				Debug("Created PersistentVolume")
 
 			// Bind claim to volume
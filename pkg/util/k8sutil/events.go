@@ -189,6 +189,16 @@ func NewPlanAbortedEvent(apiObject APIObject, itemType, memberID, role string) *
 	return event
 }
 
+// NewPlanCancelledEvent creates an event indicating that an item was removed from a reconciliation plan
+// on user request.
+func NewPlanCancelledEvent(apiObject APIObject, itemType, memberID, role string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = core.EventTypeNormal
+	event.Reason = "Reconciliation Plan Cancelled"
+	event.Message = fmt.Sprintf("A plan item of type %s for member %s with role %s has been cancelled", itemType, memberID, role)
+	return event
+}
+
 // NewCannotChangeStorageClassEvent creates an event indicating that an item would need to use a different StorageClass,
 // but this is not possible for the given reason.
 func NewCannotChangeStorageClassEvent(apiObject APIObject, memberID, role, subReason string) *Event {
@@ -218,6 +228,33 @@ func NewPVCResizedEvent(apiObject APIObject, pvcname string) *Event {
 	return event
 }
 
+// NewPVCAutoGrownEvent creates an event indicating that a PVC has been grown automatically
+func NewPVCAutoGrownEvent(apiObject APIObject, pvcname, from, to string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = core.EventTypeNormal
+	event.Reason = "PVC Auto Grown"
+	event.Message = fmt.Sprintf("The persistent volume claim %s has been grown from %s to %s", pvcname, from, to)
+	return event
+}
+
+// NewGroupAutoscaledEvent creates an event indicating that the count of the group has been changed by the autoscaler
+func NewGroupAutoscaledEvent(apiObject APIObject, role string, from, to int, reason string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = core.EventTypeNormal
+	event.Reason = "Group Autoscaled"
+	event.Message = fmt.Sprintf("The count of the %s group has been changed from %d to %d: %s", role, from, to, reason)
+	return event
+}
+
+// NewGroupScaleSkippedEvent creates an event indicating that the count of the group has not been changed, because reconciliation is paused
+func NewGroupScaleSkippedEvent(apiObject APIObject, role string, from, to int, source string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = core.EventTypeNormal
+	event.Reason = "Group Scale Skipped"
+	event.Message = fmt.Sprintf("The count of the %s group has not been changed from %d to %d by the %s, reconciliation is paused", role, from, to, source)
+	return event
+}
+
 // NewCannotShrinkVolumeEvent creates an event indicating that the user tried to shrink a PVC
 func NewCannotShrinkVolumeEvent(apiObject APIObject, pvcname string) *Event {
 	event := newDeploymentEvent(apiObject)
@@ -227,6 +264,15 @@ func NewCannotShrinkVolumeEvent(apiObject APIObject, pvcname string) *Event {
 	return event
 }
 
+// NewRestoreRejectedEvent creates an event indicating that a restore has been rejected by the pre-flight checks.
+func NewRestoreRejectedEvent(apiObject APIObject, restoreName, reason string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = core.EventTypeWarning
+	event.Reason = "Restore Rejected"
+	event.Message = fmt.Sprintf("The restore %s has been rejected: %s", restoreName, reason)
+	return event
+}
+
 // NewUpgradeNotAllowedEvent creates an event indicating that an upgrade (or downgrade) is not allowed.
 func NewUpgradeNotAllowedEvent(apiObject APIObject,
 	fromVersion, toVersion driver.Version,
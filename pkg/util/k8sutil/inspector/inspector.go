@@ -30,6 +30,8 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangoclustersynchronization"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangodeployment"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangomember"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangomembergroup"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangorestore"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangotask"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/endpoints"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/mods"
@@ -86,6 +88,8 @@ type Inspector interface {
 	node.Inspector
 	arangoclustersynchronization.Inspector
 	arangotask.Inspector
+	arangomembergroup.Inspector
+	arangorestore.Inspector
 
 	mods.Mods
 }
@@ -21,6 +21,9 @@
 package mods
 
 import (
+	arangomembergroupv1 "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangomembergroup/v1"
+	arangorestorev1 "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangorestore/v1"
+	arangotaskv1 "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangotask/v1"
 	endpointsv1 "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/endpoints/v1"
 	persistentvolumeclaimv1 "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/persistentvolumeclaim/v1"
 	podv1 "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/pod/v1"
@@ -65,6 +68,18 @@ type PodDisruptionBudgetsMods interface {
 	V1Beta1() v1beta1.ModInterface
 }
 
+type ArangoTasksMods interface {
+	V1() arangotaskv1.ModInterface
+}
+
+type ArangoMemberGroupsMods interface {
+	V1() arangomembergroupv1.ModInterface
+}
+
+type ArangoRestoresMods interface {
+	V1() arangorestorev1.ModInterface
+}
+
 type Mods interface {
 	PodsModInterface() PodsMods
 	ServiceAccountsModInterface() ServiceAccountsMods
@@ -74,4 +89,7 @@ type Mods interface {
 	EndpointsModInterface() EndpointsMods
 	ServiceMonitorsModInterface() ServiceMonitorsMods
 	PodDisruptionBudgetsModInterface() PodDisruptionBudgetsMods
+	ArangoTasksModInterface() ArangoTasksMods
+	ArangoMemberGroupsModInterface() ArangoMemberGroupsMods
+	ArangoRestoresModInterface() ArangoRestoresMods
 }
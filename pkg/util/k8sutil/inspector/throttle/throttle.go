@@ -30,13 +30,15 @@ type Inspector interface {
 }
 
 func NewAlwaysThrottleComponents() Components {
-	return NewThrottleComponents(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	return NewThrottleComponents(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
 }
 
-func NewThrottleComponents(acs, am, at, node, pvc, pod, pdb, secret, service, serviceAccount, sm, endpoints time.Duration) Components {
+func NewThrottleComponents(acs, am, amg, ar, at, node, pvc, pod, pdb, secret, service, serviceAccount, sm, endpoints time.Duration) Components {
 	return &throttleComponents{
 		arangoClusterSynchronization: NewThrottle(acs),
 		arangoMember:                 NewThrottle(am),
+		arangoMemberGroup:            NewThrottle(amg),
+		arangoRestore:                NewThrottle(ar),
 		arangoTask:                   NewThrottle(at),
 		node:                         NewThrottle(node),
 		persistentVolumeClaim:        NewThrottle(pvc),
@@ -57,6 +59,8 @@ type Component string
 const (
 	ArangoClusterSynchronization Component = "ArangoClusterSynchronization"
 	ArangoMember                 Component = "ArangoMember"
+	ArangoMemberGroup            Component = "ArangoMemberGroup"
+	ArangoRestore                Component = "ArangoRestore"
 	ArangoTask                   Component = "ArangoTask"
 	Node                         Component = "Node"
 	PersistentVolumeClaim        Component = "PersistentVolumeClaim"
@@ -73,6 +77,8 @@ func AllComponents() []Component {
 	return []Component{
 		ArangoClusterSynchronization,
 		ArangoMember,
+		ArangoMemberGroup,
+		ArangoRestore,
 		ArangoTask,
 		Node,
 		PersistentVolumeClaim,
@@ -89,6 +95,8 @@ func AllComponents() []Component {
 type Components interface {
 	ArangoClusterSynchronization() Throttle
 	ArangoMember() Throttle
+	ArangoMemberGroup() Throttle
+	ArangoRestore() Throttle
 	ArangoTask() Throttle
 	Node() Throttle
 	PersistentVolumeClaim() Throttle
@@ -110,6 +118,8 @@ type Components interface {
 type throttleComponents struct {
 	arangoClusterSynchronization Throttle
 	arangoMember                 Throttle
+	arangoMemberGroup            Throttle
+	arangoRestore                Throttle
 	arangoTask                   Throttle
 	node                         Throttle
 	persistentVolumeClaim        Throttle
@@ -151,6 +161,10 @@ func (t *throttleComponents) Get(c Component) Throttle {
 		return t.arangoClusterSynchronization
 	case ArangoMember:
 		return t.arangoMember
+	case ArangoMemberGroup:
+		return t.arangoMemberGroup
+	case ArangoRestore:
+		return t.arangoRestore
 	case ArangoTask:
 		return t.arangoTask
 	case Node:
@@ -180,6 +194,8 @@ func (t *throttleComponents) Copy() Components {
 	return &throttleComponents{
 		arangoClusterSynchronization: t.arangoClusterSynchronization.Copy(),
 		arangoMember:                 t.arangoMember.Copy(),
+		arangoMemberGroup:            t.arangoMemberGroup.Copy(),
+		arangoRestore:                t.arangoRestore.Copy(),
 		arangoTask:                   t.arangoTask.Copy(),
 		node:                         t.node.Copy(),
 		persistentVolumeClaim:        t.persistentVolumeClaim.Copy(),
@@ -201,6 +217,14 @@ func (t *throttleComponents) ArangoMember() Throttle {
 	return t.arangoMember
 }
 
+func (t *throttleComponents) ArangoMemberGroup() Throttle {
+	return t.arangoMemberGroup
+}
+
+func (t *throttleComponents) ArangoRestore() Throttle {
+	return t.arangoRestore
+}
+
 func (t *throttleComponents) ArangoTask() Throttle {
 	return t.arangoTask
 }
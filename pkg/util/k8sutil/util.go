@@ -46,6 +46,9 @@ const (
 	LabelKeyArangoTopology = "deployment.arangodb.com/topology"
 	// LabelKeyArangoLeader is the key of the label used to store the current leader of a group instances.
 	LabelKeyArangoLeader = "deployment.arangodb.com/leader"
+	// LabelKeyArangoBackupVerification is the key of the label used to store the UID of the ArangoBackup verified
+	// with the scratch deployment
+	LabelKeyArangoBackupVerification = "backup.arangodb.com/verification"
 	// AppName is the fixed value for the "app" label
 	AppName = "arangodb"
 )
@@ -110,6 +110,7 @@ type FakeDataInput struct {
 	Nodes           map[string]*core.Node
 	ACS             map[string]*api.ArangoClusterSynchronization
 	AT              map[string]*api.ArangoTask
+	AMG             map[string]*api.ArangoMemberGroup
 }
 
 func (f FakeDataInput) asList() []runtime.Object {
@@ -211,6 +212,14 @@ func (f FakeDataInput) asList() []runtime.Object {
 		}
 		r = append(r, c)
 	}
+	for k, v := range f.AMG {
+		c := v.DeepCopy()
+		c.SetName(k)
+		if c.GetNamespace() == "" && f.Namespace != "" {
+			c.SetNamespace(f.Namespace)
+		}
+		r = append(r, c)
+	}
 
 	for _, o := range r {
 		if f.Namespace != "" {